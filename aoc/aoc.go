@@ -0,0 +1,129 @@
+// Package aoc defines the Solver interface and the registry that day packages
+// add their solvers to from init.
+package aoc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"math/rand"
+	"strconv"
+)
+
+// Answer is the result of solving one part of a puzzle: an int, or a
+// big.Int for answers that do not fit in one.
+type Answer struct {
+	n   int
+	big *big.Int // set only when the answer does not fit in an int
+}
+
+// Int returns an Answer holding n.
+func Int(n int) Answer {
+	return Answer{n: n}
+}
+
+// Big returns an Answer holding a copy of x. Answers that fit in an int are
+// stored as one, so Big and Int agree on small values.
+func Big(x *big.Int) Answer {
+	if x.IsInt64() && x.Int64() >= math.MinInt && x.Int64() <= math.MaxInt {
+		return Int(int(x.Int64()))
+	}
+	return Answer{big: new(big.Int).Set(x)}
+}
+
+// IsBig reports whether the answer is too large for an int.
+func (a Answer) IsBig() bool {
+	return a.big != nil
+}
+
+// String formats the answer the way the puzzle site expects it.
+func (a Answer) String() string {
+	if a.big != nil {
+		return a.big.String()
+	}
+	return strconv.Itoa(a.n)
+}
+
+// MarshalJSON writes the answer as a JSON number.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// DefaultVariant names the implementation used when no variant is asked for.
+const DefaultVariant = "default"
+
+// Solver solves one part of one day's puzzle.
+type Solver interface {
+	Day() int
+	Part() int
+	// Variant tells alternative implementations of the same part apart.
+	Variant() string
+	Title() string
+	Solve(ctx context.Context, r io.Reader) (Answer, error)
+}
+
+// SolveFunc is the signature of Solver.Solve.
+type SolveFunc func(ctx context.Context, r io.Reader) (Answer, error)
+
+// IntFunc adapts a day package's PartN function to a SolveFunc.
+func IntFunc(part func(context.Context, io.Reader) (int, error)) SolveFunc {
+	return func(ctx context.Context, r io.Reader) (Answer, error) {
+		n, err := part(ctx, r)
+		if err != nil {
+			return Answer{}, err
+		}
+		return Int(n), nil
+	}
+}
+
+// ParseFunc reads a day's puzzle input into the day's own representation
+// and discards it. It lets tools time parsing apart from solving.
+type ParseFunc func(r io.Reader) error
+
+// ParseOnly adapts a day package's input reader to a ParseFunc.
+func ParseOnly[T any](read func(io.Reader) (T, error)) ParseFunc {
+	return func(r io.Reader) error {
+		_, err := read(r)
+		return err
+	}
+}
+
+// GenerateFunc writes a synthetic puzzle input of about size items (lines,
+// cards, nodes and so on, as the day documents) to w, drawing every random
+// choice from rng so that a seed reproduces the input. Generated inputs are
+// valid and have an answer.
+type GenerateFunc func(w io.Writer, size int, rng *rand.Rand) error
+
+type solver struct {
+	day, part int
+	variant   string
+	title     string
+	solve     SolveFunc
+}
+
+func (s *solver) Day() int        { return s.day }
+func (s *solver) Part() int       { return s.part }
+func (s *solver) Variant() string { return s.variant }
+func (s *solver) Title() string   { return s.title }
+
+func (s *solver) Solve(ctx context.Context, r io.Reader) (Answer, error) {
+	return s.solve(ctx, r)
+}
+
+// New returns the default Solver for a day and part.
+func New(day, part int, title string, solve SolveFunc) Solver {
+	return NewVariant(day, part, DefaultVariant, title, solve)
+}
+
+// NewVariant returns a Solver registered under a named variant, so that
+// alternative implementations of a part can live side by side.
+func NewVariant(day, part int, variant, title string, solve SolveFunc) Solver {
+	return &solver{day: day, part: part, variant: variant, title: title, solve: solve}
+}
+
+// Name identifies a solver as "day/part/variant".
+func Name(s Solver) string {
+	return fmt.Sprintf("%d/%d/%s", s.Day(), s.Part(), s.Variant())
+}
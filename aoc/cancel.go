@@ -0,0 +1,40 @@
+package aoc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CheckEvery is how many iterations a hot loop may run between calls to
+// Canceled; checking a context costs more than a loop step.
+const CheckEvery = 1 << 16
+
+// CanceledError reports a solver stopped by its context before it finished.
+type CanceledError struct {
+	// Progress describes how far the solver got, e.g. "seed 1200 of 5000".
+	Progress string
+	Err      error // the context's error
+}
+
+func (e *CanceledError) Error() string {
+	return fmt.Sprintf("stopped at %s: %v", e.Progress, e.Err)
+}
+
+func (e *CanceledError) Unwrap() error {
+	return e.Err
+}
+
+// Canceled returns a *CanceledError describing the solver's progress if ctx
+// is done, and nil otherwise. Hot loops call it every CheckEvery iterations:
+//
+//	if i%aoc.CheckEvery == 0 {
+//		if err := aoc.Canceled(ctx, "step %d", i); err != nil {
+//			return 0, err
+//		}
+//	}
+func Canceled(ctx context.Context, format string, args ...any) error {
+	if err := ctx.Err(); err != nil {
+		return &CanceledError{Progress: fmt.Sprintf(format, args...), Err: err}
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package aoc
+
+import (
+	"context"
+	"log/slog"
+)
+
+// LevelTrace is below slog.LevelDebug, for per-step diagnostics such as
+// search states and DP tables that are only worth reading on small inputs.
+const LevelTrace = slog.LevelDebug - 4
+
+type loggerKey struct{}
+
+// WithLogger returns a context that carries l to the solvers run with it.
+func WithLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+// Logger returns the logger carried by ctx. Without one, solvers log to a
+// logger that discards everything, so answers are all they print.
+func Logger(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return l
+	}
+	return discard
+}
+
+var discard = slog.New(discardHandler{})
+
+type discardHandler struct{}
+
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
@@ -0,0 +1,172 @@
+package aoc
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+var (
+	mu         sync.RWMutex
+	registry   = make(map[key]Solver)
+	parsers    = make(map[int]ParseFunc)
+	generators = make(map[int]GenerateFunc)
+	references = make(map[[2]int]string) // day and part to variant
+	sizeLimits = make(map[[2]int]int)    // day and part to largest input size
+)
+
+type key struct {
+	day, part int
+	variant   string
+}
+
+func keyOf(s Solver) key {
+	return key{s.Day(), s.Part(), s.Variant()}
+}
+
+// Register adds s to the registry. It panics if a solver with the same day,
+// part and variant is already registered, or if the day or part is out of range.
+func Register(s Solver) {
+	if s.Day() < 1 || s.Day() > 25 || (s.Part() != 1 && s.Part() != 2) {
+		panic(fmt.Sprintf("aoc: invalid solver %s", Name(s)))
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	k := keyOf(s)
+	if _, dup := registry[k]; dup {
+		panic(fmt.Sprintf("aoc: solver %s registered twice", Name(s)))
+	}
+	registry[k] = s
+}
+
+// Lookup returns the solver registered for a day, part and variant.
+func Lookup(day, part int, variant string) (Solver, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	s, ok := registry[key{day, part, variant}]
+	return s, ok
+}
+
+// RegisterParser records the function that parses a day's input, shared by
+// both parts. It panics if the day already has one.
+func RegisterParser(day int, parse ParseFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, dup := parsers[day]; dup {
+		panic(fmt.Sprintf("aoc: parser for day %d registered twice", day))
+	}
+	parsers[day] = parse
+}
+
+// LookupParser returns the parser registered for a day.
+func LookupParser(day int) (ParseFunc, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	parse, ok := parsers[day]
+	return parse, ok
+}
+
+// RegisterGenerator records the function that writes synthetic inputs for a
+// day. It panics if the day already has one.
+func RegisterGenerator(day int, gen GenerateFunc) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, dup := generators[day]; dup {
+		panic(fmt.Sprintf("aoc: generator for day %d registered twice", day))
+	}
+	generators[day] = gen
+}
+
+// LookupGenerator returns the input generator registered for a day.
+func LookupGenerator(day int) (GenerateFunc, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	gen, ok := generators[day]
+	return gen, ok
+}
+
+// RegisterReference names the variant of a day and part that the other
+// variants are checked against: the plainest implementation, trusted over
+// faster ones. It panics if the part already has a reference.
+func RegisterReference(day, part int, variant string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, dup := references[[2]int{day, part}]; dup {
+		panic(fmt.Sprintf("aoc: reference for day %d part %d registered twice", day, part))
+	}
+	references[[2]int{day, part}] = variant
+}
+
+// Reference returns the reference solver for a day and part.
+func Reference(day, part int) (Solver, bool) {
+	mu.RLock()
+	variant, ok := references[[2]int{day, part}]
+	mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	return Lookup(day, part, variant)
+}
+
+// RegisterSizeLimit records the largest generated input size a part can
+// solve, for parts that read the day's input in a way that overflows on
+// larger ones. It panics if the part already has a limit.
+func RegisterSizeLimit(day, part, size int) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, dup := sizeLimits[[2]int{day, part}]; dup {
+		panic(fmt.Sprintf("aoc: size limit for day %d part %d registered twice", day, part))
+	}
+	sizeLimits[[2]int{day, part}] = size
+}
+
+// SizeLimit returns the largest generated input size a day and part can
+// solve, if it has a limit.
+func SizeLimit(day, part int) (int, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	size, ok := sizeLimits[[2]int{day, part}]
+	return size, ok
+}
+
+// Filter selects solvers. Zero fields match everything.
+type Filter struct {
+	Day     int
+	Part    int
+	Variant string
+}
+
+// Match reports whether s passes the filter.
+func (f Filter) Match(s Solver) bool {
+	return (f.Day == 0 || f.Day == s.Day()) &&
+		(f.Part == 0 || f.Part == s.Part()) &&
+		(f.Variant == "" || f.Variant == s.Variant())
+}
+
+// Solvers returns the registered solvers that match f, ordered by day, part
+// and variant with the default variant first.
+func Solvers(f Filter) []Solver {
+	mu.RLock()
+	var list []Solver
+	for _, s := range registry {
+		if f.Match(s) {
+			list = append(list, s)
+		}
+	}
+	mu.RUnlock()
+
+	sort.Slice(list, func(i, j int) bool {
+		a, b := list[i], list[j]
+		if a.Day() != b.Day() {
+			return a.Day() < b.Day()
+		}
+		if a.Part() != b.Part() {
+			return a.Part() < b.Part()
+		}
+		if (a.Variant() == DefaultVariant) != (b.Variant() == DefaultVariant) {
+			return a.Variant() == DefaultVariant
+		}
+		return a.Variant() < b.Variant()
+	})
+	return list
+}
@@ -0,0 +1,297 @@
+// Package bench times solvers on their puzzle inputs and compares the
+// timings with a saved baseline.
+package bench
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/metrics"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+// Result is the measurement of one solver on one input.
+type Result struct {
+	Solver string `json:"solver"` // aoc.Name of the solver
+	Input  string `json:"input"`  // base name of the input file, or "example"
+	Runs   int    `json:"runs"`
+
+	// Parse is the fastest run of the day's parser alone, and Solve the
+	// fastest full solve minus Parse.
+	Parse time.Duration `json:"parse_ns"`
+	Solve time.Duration `json:"solve_ns"`
+
+	// Allocs and Bytes are heap allocations per solve; Peak is the largest
+	// live heap above its starting size seen during a solve.
+	Allocs uint64 `json:"allocs"`
+	Bytes  uint64 `json:"bytes"`
+	Peak   uint64 `json:"peak_bytes"`
+}
+
+// Total is the fastest full solve, parsing included.
+func (r Result) Total() time.Duration {
+	return r.Parse + r.Solve
+}
+
+func (r Result) key() string {
+	return r.Solver + " " + r.Input
+}
+
+// Run solves the input runs times with s and reports the best timings. The
+// input is read into memory first so that disk access is not measured. A
+// positive timeout limits each run.
+func Run(ctx context.Context, s aoc.Solver, inputPath string, runs int, timeout time.Duration) (Result, error) {
+	if runs < 1 {
+		runs = 1
+	}
+	res := Result{Solver: aoc.Name(s), Input: filepath.Base(inputPath), Runs: runs}
+
+	file, err := input.Open(s.Day(), inputPath)
+	if err != nil {
+		return res, err
+	}
+	data, err := io.ReadAll(file)
+	file.Close()
+	if err != nil {
+		return res, err
+	}
+
+	parse, hasParser := aoc.LookupParser(s.Day())
+	var total time.Duration
+	for i := 0; i < runs; i++ {
+		if hasParser {
+			start := time.Now()
+			if err := parse(bytes.NewReader(data)); err != nil {
+				return res, input.WithFile(err, res.Input)
+			}
+			if d := time.Since(start); i == 0 || d < res.Parse {
+				res.Parse = d
+			}
+		}
+
+		m, err := measure(func() error {
+			ctx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			_, err := s.Solve(ctx, bytes.NewReader(data))
+			return err
+		})
+		if err != nil {
+			return res, input.WithFile(err, res.Input)
+		}
+		if i == 0 || m.elapsed < total {
+			total = m.elapsed
+		}
+		res.Allocs += m.allocs
+		res.Bytes += m.bytes
+		if m.peak > res.Peak {
+			res.Peak = m.peak
+		}
+	}
+
+	if total > res.Parse {
+		res.Solve = total - res.Parse
+	}
+	res.Allocs /= uint64(runs)
+	res.Bytes /= uint64(runs)
+	return res, nil
+}
+
+type measurement struct {
+	elapsed       time.Duration
+	allocs, bytes uint64
+	peak          uint64
+}
+
+// heapMetric is the live heap size, which unlike runtime.ReadMemStats can be
+// sampled without stopping the world.
+const heapMetric = "/memory/classes/heap/objects:bytes"
+
+// peakSampleInterval is how often the live heap is sampled during a run;
+// peaks shorter than this can be missed.
+const peakSampleInterval = time.Millisecond
+
+// measure runs fn once, recording its run time, its allocations and the
+// peak of the live heap above where it started.
+func measure(fn func() error) (measurement, error) {
+	runtime.GC()
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	base := heapBytes()
+
+	var (
+		wg   sync.WaitGroup
+		peak uint64
+		done = make(chan struct{})
+	)
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(peakSampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if h := heapBytes(); h > peak {
+					peak = h
+				}
+			}
+		}
+	}()
+
+	start := time.Now()
+	err := fn()
+	elapsed := time.Since(start)
+	end := heapBytes()
+	close(done)
+	wg.Wait()
+	if end > peak {
+		peak = end
+	}
+	runtime.ReadMemStats(&after)
+
+	m := measurement{
+		elapsed: elapsed,
+		allocs:  after.Mallocs - before.Mallocs,
+		bytes:   after.TotalAlloc - before.TotalAlloc,
+	}
+	if peak > base {
+		m.peak = peak - base
+	}
+	return m, err
+}
+
+func heapBytes() uint64 {
+	sample := []metrics.Sample{{Name: heapMetric}}
+	metrics.Read(sample)
+	if sample[0].Value.Kind() != metrics.KindUint64 {
+		return 0
+	}
+	return sample[0].Value.Uint64()
+}
+
+// Save writes results to path as JSON, for use as a later baseline.
+func Save(path string, results []Result) error {
+	data, err := json.MarshalIndent(results, "", "\t")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Load reads results saved by Save.
+func Load(path string) ([]Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var results []Result
+	if err := json.Unmarshal(data, &results); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return results, nil
+}
+
+// Comparison pairs a result with the baseline result for the same solver
+// and input, if there is one.
+type Comparison struct {
+	Result
+	Baseline *Result
+	// Change is the relative change in total time, 0.25 meaning 25% slower.
+	Change float64
+	// Regressed is set when Change exceeds the threshold given to Compare.
+	Regressed bool
+}
+
+// Compare matches results against a baseline, flagging those whose total
+// time grew by more than threshold (0.1 for 10%).
+func Compare(results, baseline []Result, threshold float64) []Comparison {
+	old := make(map[string]Result, len(baseline))
+	for _, r := range baseline {
+		old[r.key()] = r
+	}
+
+	comps := make([]Comparison, len(results))
+	for i, r := range results {
+		comps[i].Result = r
+		b, ok := old[r.key()]
+		if !ok || b.Total() <= 0 {
+			continue
+		}
+		comps[i].Baseline = &b
+		comps[i].Change = float64(r.Total()-b.Total()) / float64(b.Total())
+		comps[i].Regressed = comps[i].Change > threshold
+	}
+	return comps
+}
+
+// WriteTable prints one row per comparison. The baseline columns are added
+// when any row has a baseline, and regressions are marked with '!'.
+func WriteTable(w io.Writer, comps []Comparison) error {
+	withBaseline := false
+	for _, c := range comps {
+		withBaseline = withBaseline || c.Baseline != nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	header := "SOLVER\tINPUT\tPARSE\tSOLVE\tALLOCS\tBYTES\tPEAK\t"
+	if withBaseline {
+		header += "BASELINE\tCHANGE\t"
+	}
+	fmt.Fprintln(tw, header)
+	for _, c := range comps {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%d\t%s\t%s\t", c.Solver, c.Input,
+			round(c.Parse), round(c.Solve), c.Allocs, formatBytes(c.Bytes), formatBytes(c.Peak))
+		switch {
+		case !withBaseline:
+		case c.Baseline == nil:
+			fmt.Fprint(tw, "-\t-\t")
+		default:
+			mark := ""
+			if c.Regressed {
+				mark = " !"
+			}
+			fmt.Fprintf(tw, "%s\t%+.1f%%%s\t", round(c.Baseline.Total()), 100*c.Change, mark)
+		}
+		fmt.Fprintln(tw)
+	}
+	return tw.Flush()
+}
+
+// round trims a duration to three significant digits.
+func round(d time.Duration) time.Duration {
+	for unit := time.Duration(1); unit < time.Hour; unit *= 10 {
+		if d < 1000*unit {
+			return d.Round(unit)
+		}
+	}
+	return d
+}
+
+func formatBytes(n uint64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%dB", n)
+	}
+	div, exp := uint64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f%ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,174 @@
+package bench
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"adventofcode23/aoc"
+	_ "adventofcode23/days"
+	"adventofcode23/input"
+	"adventofcode23/verify"
+)
+
+// manifestInputs returns the contents of every input in answers.txt, keyed
+// by "dayN/input".
+func manifestInputs(b *testing.B) ([]verify.Entry, map[string][]byte) {
+	entries, err := verify.Load("../answers.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	data := make(map[string][]byte)
+	for _, e := range entries {
+		k := inputKey(e)
+		if _, ok := data[k]; ok {
+			continue
+		}
+		f, err := input.Open(e.Day, e.Input)
+		if err != nil {
+			b.Fatal(err)
+		}
+		data[k], err = io.ReadAll(f)
+		f.Close()
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+	return entries, data
+}
+
+func inputKey(e verify.Entry) string {
+	return fmt.Sprintf("day%d/%s", e.Day, filepath.Base(e.Input))
+}
+
+// BenchmarkSolvers times every registered solver on each of its inputs in
+// the answers manifest, e.g. BenchmarkSolvers/day11/part2/default/day11.txt.
+func BenchmarkSolvers(b *testing.B) {
+	entries, data := manifestInputs(b)
+	ctx := context.Background()
+	for _, e := range entries {
+		in := data[inputKey(e)]
+		for _, s := range aoc.Solvers(aoc.Filter{Day: e.Day, Part: e.Part}) {
+			s := s
+			name := fmt.Sprintf("day%d/part%d/%s/%s", e.Day, e.Part, s.Variant(), filepath.Base(e.Input))
+			b.Run(name, func(b *testing.B) {
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := s.Solve(ctx, bytes.NewReader(in)); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// generatedSize is the size of the generated inputs BenchmarkGenerated
+// uses, the gen command's default.
+const generatedSize = 100
+
+// BenchmarkGenerated times every registered solver on a generated input of
+// its day, e.g. BenchmarkGenerated/day5/part2/default. Unlike the manifest
+// inputs these exist for every day with a generator, so the parts without
+// an accepted answer are timed too; the answers are not checked.
+func BenchmarkGenerated(b *testing.B) {
+	ctx := context.Background()
+	for _, s := range aoc.Solvers(aoc.Filter{}) {
+		s := s
+		gen, ok := aoc.LookupGenerator(s.Day())
+		if !ok {
+			continue
+		}
+		size := generatedSize
+		if limit, ok := aoc.SizeLimit(s.Day(), s.Part()); ok {
+			size = min(size, limit)
+		}
+		var in bytes.Buffer
+		if err := gen(&in, size, rand.New(rand.NewSource(1))); err != nil {
+			b.Fatal(err)
+		}
+		b.Run(fmt.Sprintf("day%d/part%d/%s", s.Day(), s.Part(), s.Variant()), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := s.Solve(ctx, bytes.NewReader(in.Bytes())); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkParsers times each day's parser alone on its manifest inputs.
+func BenchmarkParsers(b *testing.B) {
+	entries, data := manifestInputs(b)
+	seen := make(map[string]bool)
+	for _, e := range entries {
+		k := inputKey(e)
+		parse, ok := aoc.LookupParser(e.Day)
+		if seen[k] || !ok {
+			continue
+		}
+		seen[k] = true
+		in := data[k]
+		b.Run(k, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := parse(bytes.NewReader(in)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	baseline := []Result{
+		{Solver: "1/1/default", Input: "day1.txt", Parse: 10 * time.Millisecond, Solve: 90 * time.Millisecond},
+		{Solver: "1/2/default", Input: "day1.txt", Solve: 100 * time.Millisecond},
+	}
+	results := []Result{
+		{Solver: "1/1/default", Input: "day1.txt", Parse: 10 * time.Millisecond, Solve: 95 * time.Millisecond},
+		{Solver: "1/2/default", Input: "day1.txt", Solve: 120 * time.Millisecond},
+		{Solver: "2/1/default", Input: "day2.txt", Solve: time.Second},
+	}
+	comps := Compare(results, baseline, 0.1)
+
+	if c := comps[0]; c.Baseline == nil || c.Regressed || c.Change < 0.049 || c.Change > 0.051 {
+		t.Errorf("5%% slowdown with a 10%% threshold: %+v", c)
+	}
+	// 20% over the 100ms baseline is beyond the threshold.
+	if c := comps[1]; !c.Regressed {
+		t.Errorf("20%% slowdown not flagged: %+v", c)
+	}
+	if c := comps[2]; c.Baseline != nil || c.Regressed {
+		t.Errorf("result without a baseline: %+v", c)
+	}
+
+	var buf bytes.Buffer
+	if err := WriteTable(&buf, comps); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("+20.0% !")) {
+		t.Errorf("table does not flag the regression:\n%s", buf.String())
+	}
+}
+
+func TestSaveLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "baseline.json")
+	want := []Result{{Solver: "6/2/default", Input: "day6.txt", Runs: 3, Parse: time.Microsecond, Solve: time.Second, Allocs: 24, Bytes: 1 << 16, Peak: 1 << 10}}
+	if err := Save(path, want); err != nil {
+		t.Fatal(err)
+	}
+	got, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0] != want[0] {
+		t.Errorf("Load = %+v, want %+v", got, want)
+	}
+}
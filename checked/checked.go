@@ -0,0 +1,118 @@
+// Package checked provides int arithmetic that reports overflow, and
+// accumulators that switch to math/big once an int is no longer enough.
+package checked
+
+import (
+	"math"
+	"math/big"
+)
+
+// Add returns a+b and whether the sum fits in an int.
+func Add(a, b int) (int, bool) {
+	s := a + b
+	return s, (s > a) == (b > 0)
+}
+
+// Mul returns a*b and whether the product fits in an int.
+func Mul(a, b int) (int, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	p := a * b
+	if (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) || p/b != a {
+		return p, false
+	}
+	return p, true
+}
+
+// Sum adds up ints, moving to big.Int arithmetic on overflow. The zero
+// value is an empty sum.
+type Sum struct {
+	n   int
+	big *big.Int // set once the sum has overflowed
+}
+
+// Add adds x to the sum.
+func (s *Sum) Add(x int) {
+	if s.big == nil {
+		if n, ok := Add(s.n, x); ok {
+			s.n = n
+			return
+		}
+		s.big = big.NewInt(int64(s.n))
+	}
+	s.big.Add(s.big, big.NewInt(int64(x)))
+}
+
+// AddBig adds x to the sum.
+func (s *Sum) AddBig(x *big.Int) {
+	if x.IsInt64() && x.Int64() >= math.MinInt && x.Int64() <= math.MaxInt {
+		s.Add(int(x.Int64()))
+		return
+	}
+	if s.big == nil {
+		s.big = big.NewInt(int64(s.n))
+	}
+	s.big.Add(s.big, x)
+}
+
+// AddProduct adds a*b to the sum.
+func (s *Sum) AddProduct(a, b int) {
+	if p, ok := Mul(a, b); ok {
+		s.Add(p)
+		return
+	}
+	s.AddBig(new(big.Int).Mul(big.NewInt(int64(a)), big.NewInt(int64(b))))
+}
+
+// Int returns the sum and whether it fits in an int.
+func (s *Sum) Int() (int, bool) {
+	return s.n, s.big == nil
+}
+
+// Big returns the sum as a new big.Int.
+func (s *Sum) Big() *big.Int {
+	if s.big == nil {
+		return big.NewInt(int64(s.n))
+	}
+	return new(big.Int).Set(s.big)
+}
+
+// Product multiplies ints, moving to big.Int arithmetic on overflow. The
+// zero value is an empty product, which is 1.
+type Product struct {
+	n       int
+	started bool
+	big     *big.Int // set once the product has overflowed
+}
+
+// Mul multiplies the product by x.
+func (p *Product) Mul(x int) {
+	if !p.started {
+		p.n, p.started = 1, true
+	}
+	if p.big == nil {
+		if n, ok := Mul(p.n, x); ok {
+			p.n = n
+			return
+		}
+		p.big = big.NewInt(int64(p.n))
+	}
+	p.big.Mul(p.big, big.NewInt(int64(x)))
+}
+
+// Int returns the product and whether it fits in an int.
+func (p *Product) Int() (int, bool) {
+	if !p.started {
+		return 1, true
+	}
+	return p.n, p.big == nil
+}
+
+// Big returns the product as a new big.Int.
+func (p *Product) Big() *big.Int {
+	if n, ok := p.Int(); ok {
+		return big.NewInt(int64(n))
+	}
+	return new(big.Int).Set(p.big)
+}
@@ -0,0 +1,60 @@
+package checked
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddMul(t *testing.T) {
+	tests := []struct {
+		a, b           int
+		addOK, mulOK   bool
+		wantAdd, wantM int
+	}{
+		{2, 3, true, true, 5, 6},
+		{-2, 3, true, true, 1, -6},
+		{math.MaxInt, 1, false, true, 0, math.MaxInt},
+		{math.MinInt, -1, false, false, 0, 0},
+		{math.MaxInt, 0, true, true, math.MaxInt, 0},
+		{1 << 32, 1 << 31, true, false, 1<<32 + 1<<31, 0},
+		{-1, math.MinInt, false, false, 0, 0},
+	}
+	for _, tt := range tests {
+		if s, ok := Add(tt.a, tt.b); ok != tt.addOK || (ok && s != tt.wantAdd) {
+			t.Errorf("Add(%d, %d) = %d, %v", tt.a, tt.b, s, ok)
+		}
+		if p, ok := Mul(tt.a, tt.b); ok != tt.mulOK || (ok && p != tt.wantM) {
+			t.Errorf("Mul(%d, %d) = %d, %v", tt.a, tt.b, p, ok)
+		}
+	}
+}
+
+func TestSum(t *testing.T) {
+	var s Sum
+	s.Add(math.MaxInt)
+	if n, ok := s.Int(); !ok || n != math.MaxInt {
+		t.Fatalf("Int() = %d, %v before overflow", n, ok)
+	}
+	s.Add(math.MaxInt)
+	s.AddProduct(math.MaxInt, 2)
+	s.Add(-1)
+	if _, ok := s.Int(); ok {
+		t.Error("Int() reports no overflow")
+	}
+	if got, want := s.Big().String(), "36893488147419103227"; got != want {
+		t.Errorf("Big() = %s, want %s", got, want)
+	}
+}
+
+func TestProduct(t *testing.T) {
+	var p Product
+	if n, ok := p.Int(); !ok || n != 1 {
+		t.Errorf("empty product = %d, %v, want 1", n, ok)
+	}
+	for i := 0; i < 3; i++ {
+		p.Mul(4000000000)
+	}
+	if got, want := p.Big().String(), "64000000000000000000000000000"; got != want {
+		t.Errorf("Big() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"adventofcode23/aoc"
+	"adventofcode23/bench"
+	"adventofcode23/verify"
+)
+
+func benchCmd(ctx context.Context, args []string) error {
+	var f aoc.Filter
+	fs := flag.NewFlagSet("bench", flag.ContinueOnError)
+	manifest := fs.String("manifest", "answers.txt", "answers manifest listing the inputs to time")
+	fs.IntVar(&f.Day, "day", 0, "only time this day")
+	fs.IntVar(&f.Part, "part", 0, "only time this part")
+	fs.StringVar(&f.Variant, "variant", "", "only time this variant")
+	count := fs.Int("count", 3, "runs per solver; the fastest is reported")
+	save := fs.String("save", "", "write the results to this file as a baseline")
+	baseline := fs.String("baseline", "", "compare with results saved earlier by --save")
+	timeout := fs.Duration("timeout", 0, "stop each solver after this long and report its progress (0 for no limit)")
+	threshold := fs.Float64("threshold", 10, "percentage slowdown against the baseline reported as a regression")
+	var lf logFlags
+	lf.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ctx = lf.context(ctx)
+
+	entries, err := verify.Load(*manifest)
+	if err != nil {
+		return err
+	}
+	var old []bench.Result
+	if *baseline != "" {
+		if old, err = bench.Load(*baseline); err != nil {
+			return err
+		}
+	}
+
+	var results []bench.Result
+	for _, e := range entries {
+		for _, s := range aoc.Solvers(aoc.Filter{Day: e.Day, Part: e.Part, Variant: f.Variant}) {
+			if !f.Match(s) {
+				continue
+			}
+			res, err := bench.Run(ctx, s, e.Input, *count, *timeout)
+			if err != nil {
+				return fmt.Errorf("%s: %w", aoc.Name(s), err)
+			}
+			results = append(results, res)
+		}
+	}
+
+	comps := bench.Compare(results, old, *threshold/100)
+	if err := bench.WriteTable(os.Stdout, comps); err != nil {
+		return err
+	}
+	if *save != "" {
+		if err := bench.Save(*save, results); err != nil {
+			return err
+		}
+	}
+
+	regressed := 0
+	for _, c := range comps {
+		if c.Regressed {
+			regressed++
+		}
+	}
+	if regressed > 0 {
+		return fmt.Errorf("%d of %d solvers more than %g%% slower than %s", regressed, len(comps), *threshold, *baseline)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"html"
+	"io"
+	"os"
+	"runtime"
+	"strings"
+
+	"adventofcode23/day1"
+	"adventofcode23/input"
+)
+
+func calibrateCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("calibrate", flag.ContinueOnError)
+	vocabs := fs.String("vocab", "english", "comma-separated vocabularies: presets ("+strings.Join(day1.PresetNames(), ", ")+") or JSON files of words and digits")
+	digits := fs.Bool("digits", true, "count literal digits too")
+	in := fs.String("input", "day1.txt", "calibration document: a file, - for standard input, or example")
+	workers := fs.Int("j", runtime.NumCPU(), "number of goroutines summing chunks of the input")
+	chunk := fs.Int("chunk", day1.DefaultChunkSize, "size in bytes of the chunks the input is split into")
+	stats := fs.Bool("stats", false, "report the bytes, lines and throughput on standard error")
+	explain := fs.String("explain", "", "show how each line was read, highlighted for a terminal (ansi) or a browser (html)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	var style day1.Style
+	switch *explain {
+	case "":
+	case "ansi":
+		style = day1.ANSI
+	case "html":
+		style = day1.HTML
+	default:
+		return fmt.Errorf("invalid --explain %q: want ansi or html", *explain)
+	}
+
+	matcher, err := calibrationMatcher(*vocabs, *digits)
+	if err != nil {
+		return err
+	}
+	file, err := input.Open(1, *in)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	if *explain != "" {
+		return explainCalibration(ctx, os.Stdout, file, matcher, style)
+	}
+	total, st, err := day1.CalibrateParallel(ctx, file, matcher, day1.StreamConfig{Workers: *workers, ChunkSize: *chunk})
+	if err != nil {
+		return err
+	}
+	if *stats {
+		fmt.Fprintln(os.Stderr, st)
+	}
+	fmt.Println(total)
+	return nil
+}
+
+// calibrationMatcher compiles the vocabularies named by spec, a comma
+// separated list of presets and JSON files, with the literal digits if
+// digits is set.
+func calibrationMatcher(spec string, digits bool) (*day1.Matcher, error) {
+	var vocabs []day1.Vocabulary
+	if digits {
+		vocabs = append(vocabs, day1.Literal)
+	}
+	for _, name := range strings.Split(spec, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if v, ok := day1.Presets[name]; ok {
+			vocabs = append(vocabs, v)
+			continue
+		}
+		if !strings.HasSuffix(name, ".json") {
+			return nil, fmt.Errorf("unknown vocabulary %q: want one of %s or a .json file", name, strings.Join(day1.PresetNames(), ", "))
+		}
+		v, err := day1.LoadVocabulary(name)
+		if err != nil {
+			return nil, err
+		}
+		vocabs = append(vocabs, v)
+	}
+	if len(vocabs) == 0 {
+		return nil, fmt.Errorf("no vocabulary: give --vocab or --digits")
+	}
+	merged, err := day1.Merge(vocabs...)
+	if err != nil {
+		return nil, err
+	}
+	return day1.Compile(merged), nil
+}
+
+const explainHead = `<!DOCTYPE html>
+<html><head><meta charset="utf-8"><title>Calibration</title>
+<style>
+td { font-family: monospace; white-space: pre; padding: 0 1em; }
+mark.first { background: #9f9; } mark.last { background: #9ef; } mark.first.last { background: #f9f; }
+tr.none { background: #fcc; }
+</style></head><body>
+<table>
+<tr><th>line</th><th>value</th><th>text</th><th>first</th><th>last</th></tr>
+`
+
+// explainCalibration writes every line of r with its tokens highlighted,
+// their offsets and its value, then the total. Lines without a token are
+// flagged, since they silently add 0.
+func explainCalibration(ctx context.Context, w io.Writer, r io.Reader, m *day1.Matcher, style day1.Style) error {
+	bw := bufio.NewWriter(w)
+	if style == day1.HTML {
+		bw.WriteString(explainHead)
+	}
+	lines, missing := 0, 0
+	total, err := day1.Explain(ctx, r, m, func(l day1.Line) error {
+		lines++
+		if !l.Found {
+			missing++
+		}
+		token := func(t day1.Match) string {
+			return fmt.Sprintf("%q at %d-%d", l.Text[t.Start:t.End], t.Start, t.End)
+		}
+		switch {
+		case style == day1.HTML && l.Found:
+			fmt.Fprintf(bw, "<tr><td>%d</td><td>%02d</td><td>%s</td><td>%s</td><td>%s</td></tr>\n",
+				l.Number, l.Value, l.Highlight(style), html.EscapeString(token(l.First)), html.EscapeString(token(l.Last)))
+		case style == day1.HTML:
+			fmt.Fprintf(bw, "<tr class=\"none\"><td>%d</td><td>0</td><td>%s</td><td colspan=\"2\">no digits</td></tr>\n",
+				l.Number, l.Highlight(style))
+		case l.Found:
+			fmt.Fprintf(bw, "%6d  %02d  %s  first %s, last %s\n", l.Number, l.Value, l.Highlight(style), token(l.First), token(l.Last))
+		default:
+			fmt.Fprintf(bw, "%6d  \x1b[1;31m--  %s  NO DIGITS\x1b[0m\n", l.Number, l.Text)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	summary := fmt.Sprintf("total %d from %d lines, %d without digits", total, lines, missing)
+	if style == day1.HTML {
+		fmt.Fprintf(bw, "</table>\n<p>%s</p>\n</body></html>\n", summary)
+	} else {
+		fmt.Fprintln(bw, summary)
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"adventofcode23/aoc"
+	"adventofcode23/crosscheck"
+)
+
+func crosscheckCmd(ctx context.Context, args []string) error {
+	var (
+		f   aoc.Filter
+		cfg crosscheck.Config
+		lf  logFlags
+	)
+	fs := flag.NewFlagSet("crosscheck", flag.ContinueOnError)
+	fs.IntVar(&f.Day, "day", 0, "only check this day")
+	fs.IntVar(&f.Part, "part", 0, "only check this part")
+	fs.StringVar(&f.Variant, "variant", "", "only check this variant")
+	fs.IntVar(&cfg.Trials, "trials", 100, "generated inputs to try per variant")
+	fs.Int64Var(&cfg.Seed, "seed", 1, "seed of the first input; each later one adds 1")
+	fs.IntVar(&cfg.MaxSize, "size", 8, "largest input size to generate")
+	fs.DurationVar(&cfg.Timeout, "timeout", 0, "stop each solver after this long; inputs the reference cannot finish are skipped (0 for no limit)")
+	lf.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ctx = lf.context(ctx)
+
+	pairs := crosscheck.Pairs(f)
+	if len(pairs) == 0 {
+		return fmt.Errorf("no variants with a reference implementation and an input generator")
+	}
+	var results []crosscheck.Result
+	for _, p := range pairs {
+		res, err := crosscheck.Run(ctx, p, cfg)
+		if err != nil {
+			return fmt.Errorf("%s: %w", aoc.Name(p.Fast), err)
+		}
+		results = append(results, res)
+	}
+	if err := crosscheck.WriteTable(os.Stdout, results); err != nil {
+		return err
+	}
+
+	mismatched := 0
+	for _, r := range results {
+		if r.Mismatch != nil {
+			mismatched++
+		}
+	}
+	if mismatched > 0 {
+		return fmt.Errorf("%d of %d variants disagree with their reference", mismatched, len(results))
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"adventofcode23/day2"
+	"adventofcode23/input"
+)
+
+// cubeQueries are the questions cubes can ask of the day 2 games.
+var cubeQueries = []string{"possible", "minimum", "power", "violations"}
+
+// colorFlags collects the repeatable --color flag.
+type colorFlags []string
+
+func (c *colorFlags) String() string { return strings.Join(*c, ",") }
+
+func (c *colorFlags) Set(s string) error {
+	*c = append(*c, s)
+	return nil
+}
+
+func cubesCmd(args []string) error {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return fmt.Errorf("missing query: want one of %s", strings.Join(cubeQueries, ", "))
+	}
+	query, args := args[0], args[1:]
+	if !slices.Contains(cubeQueries, query) {
+		return fmt.Errorf("unknown query %q: want one of %s", query, strings.Join(cubeQueries, ", "))
+	}
+	fs := flag.NewFlagSet("cubes", flag.ContinueOnError)
+	bagSpec := fs.String("bag", day2.PuzzleBag.String(), "the cubes in the bag, as color=count pairs")
+	var colors colorFlags
+	fs.Var(&colors, "color", "add a color to the bag, or change its count, as color=count (repeatable)")
+	in := fs.String("input", "day2.txt", "game records: a file, - for standard input, or example")
+	game := fs.Int("game", 0, "only ask about the game with this ID")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	bag, err := day2.ParseBag(*bagSpec)
+	if err != nil {
+		return err
+	}
+	for _, c := range colors {
+		extra, err := day2.ParseBag(c)
+		if err != nil {
+			return err
+		}
+		for color, n := range extra {
+			bag[color] = n
+		}
+	}
+
+	file, err := input.Open(2, *in)
+	if err != nil {
+		return err
+	}
+	games, err := day2.ReadGames(file)
+	file.Close()
+	if err != nil {
+		return input.WithFile(err, *in)
+	}
+	if *game != 0 {
+		var only []day2.Game
+		for _, g := range games {
+			if g.ID == *game {
+				only = append(only, g)
+			}
+		}
+		if len(only) == 0 {
+			return fmt.Errorf("no game %d in %s", *game, *in)
+		}
+		games = only
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	switch query {
+	case "possible":
+		fmt.Fprintln(tw, "GAME\tPOSSIBLE")
+		sum, n := 0, 0
+		for _, g := range games {
+			possible := g.Possible(bag)
+			if possible {
+				sum += g.ID
+				n++
+			}
+			fmt.Fprintf(tw, "%d\t%v\n", g.ID, possible)
+		}
+		fmt.Fprintf(tw, "\n%d of %d games are possible with %s; their IDs sum to %d\n", n, len(games), bag, sum)
+	case "minimum":
+		fmt.Fprintln(tw, "GAME\tMINIMUM BAG")
+		for _, g := range games {
+			fmt.Fprintf(tw, "%d\t%s\n", g.ID, g.Minimum())
+		}
+	case "power":
+		// The power is over the colors of the bag, so that a game that
+		// never shows one of them has power 0, as in the puzzle.
+		fmt.Fprintln(tw, "GAME\tPOWER")
+		total := 0
+		for _, g := range games {
+			power := g.Minimum().Power(bag.Colors())
+			total += power
+			fmt.Fprintf(tw, "%d\t%d\n", g.ID, power)
+		}
+		fmt.Fprintf(tw, "\nthe powers over %s sum to %d\n", strings.Join(colorNames(bag), ", "), total)
+	case "violations":
+		fmt.Fprintln(tw, "GAME\tDRAW\tCOLOR\tSHOWN\tIN BAG")
+		n := 0
+		for _, g := range games {
+			vs := g.Violations(bag)
+			if len(vs) > 0 {
+				n++
+			}
+			for _, v := range vs {
+				fmt.Fprintf(tw, "%d\t%d\t%s\t%d\t%d\n", g.ID, v.Draw, v.Color, v.Count, v.Limit)
+			}
+		}
+		fmt.Fprintf(tw, "\n%d of %d games are impossible with %s\n", n, len(games), bag)
+	}
+	return tw.Flush()
+}
+
+func colorNames(b day2.Bag) []string {
+	var names []string
+	for _, c := range b.Colors() {
+		names = append(names, string(c))
+	}
+	return names
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"adventofcode23/site"
+	"adventofcode23/verify"
+)
+
+func examplesCmd(ctx context.Context, args []string) error {
+	day, args, err := parseDay(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("examples", flag.ContinueOnError)
+	htmlPath := flags.String("html", "", "puzzle description page saved from the site")
+	fetch := flags.Bool("fetch", false, "download the puzzle description from the site instead")
+	dir := flags.String("dir", ".", "root of the module holding the day and answers.txt")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var page *site.Page
+	switch {
+	case *fetch && *htmlPath != "":
+		return errors.New("examples: give --html or --fetch, not both")
+	case *fetch:
+		cfg, err := site.LoadConfig()
+		if err != nil {
+			return err
+		}
+		if page, err = site.NewClient(cfg).Page(ctx, day); err != nil {
+			return err
+		}
+	case *htmlPath != "":
+		f, err := os.Open(*htmlPath)
+		if err != nil {
+			return err
+		}
+		page, err = site.ParsePage(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%s: %w", *htmlPath, err)
+		}
+	default:
+		return errors.New("examples: --html or --fetch is required")
+	}
+	return saveExamples(os.Stdout, *dir, day, page)
+}
+
+// saveExamples writes the examples of page as fixtures under dir and adds
+// their answers to dir's manifest, reporting each to w. Fixtures and entries
+// that are already there are left alone.
+func saveExamples(w io.Writer, dir string, day int, page *site.Page) error {
+	manifest := filepath.Join(dir, "answers.txt")
+	existing, err := loadManifest(manifest)
+	if err != nil {
+		return err
+	}
+
+	// Fixture paths are relative to the manifest, like the other entries.
+	var added []verify.Entry
+	written := map[string]string{}
+	for part := 1; part <= len(page.Parts); part++ {
+		in, answer, ok := page.Example(part)
+		if !ok {
+			fmt.Fprintf(w, "day %d part %d: no example with an answer on the page\n", day, part)
+			continue
+		}
+		if answer == "" || strings.ContainsFunc(answer, unicode.IsSpace) {
+			return fmt.Errorf("day %d part %d: answer %q does not fit in the manifest", day, part, answer)
+		}
+		rel, ok := written[in]
+		if !ok {
+			rel = filepath.ToSlash(filepath.Join(fmt.Sprintf("day%d", day), "examples", fmt.Sprintf("part%d.txt", part)))
+			if err := writeFixture(filepath.Join(dir, rel), []byte(in)); err != nil {
+				return err
+			}
+			written[in] = rel
+		}
+
+		e := verify.Entry{Day: day, Part: part, Input: rel, Expected: answer}
+		if err := checkEntry(existing, e); err != nil {
+			return fmt.Errorf("%s: %w", manifest, err)
+		}
+		if !hasEntry(existing, e) {
+			added = append(added, e)
+		}
+		fmt.Fprintf(w, "day %d part %d: %s, answer %s\n", day, part, rel, answer)
+	}
+	return appendEntries(manifest, added)
+}
+
+// loadManifest reads the manifest's entries with their paths as written.
+func loadManifest(path string) ([]verify.Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	entries, err := verify.Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// writeFixture writes an example input, leaving an identical file alone and
+// refusing to overwrite a different one.
+func writeFixture(path string, data []byte) error {
+	old, err := os.ReadFile(path)
+	switch {
+	case err == nil && bytes.Equal(old, data):
+		return nil
+	case err == nil:
+		return fmt.Errorf("%s already exists with different contents; not overwriting it", path)
+	case !errors.Is(err, fs.ErrNotExist):
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return writeNew(path, data)
+}
+
+// checkEntry reports an error if the manifest already expects a different
+// answer for the same input.
+func checkEntry(entries []verify.Entry, e verify.Entry) error {
+	for _, old := range entries {
+		if old.Day == e.Day && old.Part == e.Part && old.Input == e.Input && old.Expected != e.Expected {
+			return fmt.Errorf("day %d part %d on %s already expects %s, not %s", e.Day, e.Part, e.Input, old.Expected, e.Expected)
+		}
+	}
+	return nil
+}
+
+func hasEntry(entries []verify.Entry, e verify.Entry) bool {
+	for _, old := range entries {
+		if old == e {
+			return true
+		}
+	}
+	return false
+}
+
+// appendEntries adds entries to the end of the manifest.
+func appendEntries(path string, entries []verify.Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		if _, err = fmt.Fprintf(f, "%d\t%d\t%s\t%s\n", e.Day, e.Part, e.Input, e.Expected); err != nil {
+			break
+		}
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"adventofcode23/site"
+	"adventofcode23/site/sitetest"
+)
+
+const examplePage = `<html><body><main>
+<article class="day-desc"><h2>--- Day 6: Wait For It ---</h2>
+<pre><code>Time:      7  15   30
+Distance:  9  40  200
+</code></pre>
+<p>Multiply them together to get <code><em>288</em></code>.</p>
+</article>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<p>There's really only one race, so you can win in <code><em>71503</em></code> ways.</p>
+</article>
+</main></body></html>`
+
+const manifestHead = "# day\tpart\tinput\texpected\n6\t1\tday6.txt\t1731600\n"
+
+// TestSaveExamples fetches a puzzle page from the fake site and saves its
+// examples into a scratch module, twice.
+func TestSaveExamples(t *testing.T) {
+	srv := sitetest.NewServer()
+	defer srv.Close()
+	srv.SetPage(site.DefaultYear, 6, examplePage)
+	c := site.NewClient(site.Config{Session: sitetest.Session, Year: site.DefaultYear, BaseURL: srv.URL, CacheDir: t.TempDir()})
+	c.MinInterval = 0
+
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "answers.txt")
+	if err := os.WriteFile(manifest, []byte(manifestHead), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for run := 1; run <= 2; run++ {
+		page, err := c.Page(context.Background(), 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := saveExamples(io.Discard, dir, 6, page); err != nil {
+			t.Fatalf("run %d: %v", run, err)
+		}
+
+		fixture, err := os.ReadFile(filepath.Join(dir, "day6", "examples", "part1.txt"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "Time:      7  15   30\nDistance:  9  40  200\n"; string(fixture) != want {
+			t.Errorf("run %d: fixture = %q, want %q", run, fixture, want)
+		}
+		if _, err := os.Stat(filepath.Join(dir, "day6", "examples", "part2.txt")); err == nil {
+			t.Errorf("run %d: part 2 got its own copy of part 1's example", run)
+		}
+		data, err := os.ReadFile(manifest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := manifestHead + "6\t1\tday6/examples/part1.txt\t288\n6\t2\tday6/examples/part1.txt\t71503\n"
+		if string(data) != want {
+			t.Errorf("run %d: manifest =\n%s\nwant\n%s", run, data, want)
+		}
+	}
+
+	// A different answer for an input already in the manifest is refused.
+	srv.SetPage(site.DefaultYear, 6, strings.Replace(examplePage, "288", "289", 1))
+	page, err := c.Page(context.Background(), 6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := saveExamples(io.Discard, dir, 6, page); err == nil || !strings.Contains(err.Error(), "already expects 288") {
+		t.Errorf("saveExamples with a changed answer = %v, want a conflict", err)
+	}
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"adventofcode23/site"
+)
+
+// fetchedInputPath returns the cached input for day, downloading it if needed.
+func fetchedInputPath(ctx context.Context, day int) (string, error) {
+	cfg, err := site.LoadConfig()
+	if err != nil {
+		return "", err
+	}
+	return site.NewClient(cfg).InputPath(ctx, day)
+}
+
+func fetchCmd(ctx context.Context, args []string) error {
+	day, args, err := parseDay(args)
+	if err != nil {
+		return err
+	}
+	fs := flag.NewFlagSet("fetch", flag.ContinueOnError)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	path, err := fetchedInputPath(ctx, day)
+	if err != nil {
+		return err
+	}
+	fmt.Println(path)
+	return nil
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+
+	"adventofcode23/aoc"
+)
+
+func genCmd(args []string) error {
+	day, args, err := parseDay(args)
+	if err != nil {
+		return err
+	}
+	fs := flag.NewFlagSet("gen", flag.ContinueOnError)
+	size := fs.Int("size", 100, "how large an input to generate, in the day's own unit such as lines or rows")
+	seed := fs.Int64("seed", 1, "random seed; the same seed and size give the same input")
+	out := fs.String("out", "", "write the input to this file instead of standard output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *size < 1 {
+		return fmt.Errorf("--size must be at least 1")
+	}
+	gen, ok := aoc.LookupGenerator(day)
+	if !ok {
+		return fmt.Errorf("no input generator for day %d", day)
+	}
+
+	rng := rand.New(rand.NewSource(*seed))
+	if *out == "" {
+		bw := bufio.NewWriter(os.Stdout)
+		if err := gen(bw, *size, rng); err != nil {
+			return err
+		}
+		return bw.Flush()
+	}
+
+	f, err := os.Create(*out)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(f)
+	if err := gen(bw, *size, rng); err != nil {
+		f.Close()
+		return err
+	}
+	if err := bw.Flush(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
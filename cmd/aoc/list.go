@@ -0,0 +1,28 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"adventofcode23/aoc"
+)
+
+func listCmd(args []string) error {
+	var f aoc.Filter
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	fs.IntVar(&f.Day, "day", 0, "only list this day")
+	fs.IntVar(&f.Part, "part", 0, "only list this part")
+	fs.StringVar(&f.Variant, "variant", "", "only list this variant")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "DAY\tPART\tVARIANT\tTITLE")
+	for _, s := range aoc.Solvers(f) {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\n", s.Day(), s.Part(), s.Variant(), s.Title())
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"os"
+
+	"adventofcode23/aoc"
+)
+
+// logFlags choose how much the solvers log and in what format. Logs go to
+// standard error so that answers on standard output stay clean.
+type logFlags struct {
+	verbose bool
+	trace   bool
+	json    bool
+}
+
+func (f *logFlags) register(fs *flag.FlagSet) {
+	fs.BoolVar(&f.verbose, "verbose", false, "log solver diagnostics to standard error")
+	fs.BoolVar(&f.trace, "trace", false, "also log every step; implies --verbose")
+	fs.BoolVar(&f.json, "log-json", false, "write logs as JSON lines")
+}
+
+// context returns ctx carrying the selected logger. With neither --verbose
+// nor --trace the solvers stay silent.
+func (f *logFlags) context(ctx context.Context) context.Context {
+	var level slog.Level
+	switch {
+	case f.trace:
+		level = aoc.LevelTrace
+	case f.verbose:
+		level = slog.LevelDebug
+	default:
+		return ctx
+	}
+
+	opts := &slog.HandlerOptions{Level: level, ReplaceAttr: levelNames}
+	var h slog.Handler = slog.NewTextHandler(os.Stderr, opts)
+	if f.json {
+		h = slog.NewJSONHandler(os.Stderr, opts)
+	}
+	return aoc.WithLogger(ctx, slog.New(h))
+}
+
+// levelNames prints aoc.LevelTrace as TRACE rather than DEBUG-4.
+func levelNames(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.LevelKey && len(groups) == 0 {
+		if level, ok := a.Value.Any().(slog.Level); ok && level == aoc.LevelTrace {
+			a.Value = slog.StringValue("TRACE")
+		}
+	}
+	return a
+}
@@ -0,0 +1,124 @@
+// Command aoc runs the Advent of Code 2023 solvers.
+//
+// Usage:
+//
+//	aoc fetch <day>
+//	aoc list [--day N] [--part N] [--variant name]
+//	aoc run <day> [--part 1|2] [--variant name] [--input file | --fetch] [--format text|json|csv] [--timeout d] [--verbose|--trace] [--log-json]
+//	aoc run-all [-j N] [--day N] [--part N] [--variant name|all] [--dir dir | --fetch] [--format text|json|csv] [--timeout d] [--verbose|--trace] [--log-json]
+//	aoc submit <day> [--part 1|2] [--answer A | --variant name --input file | --fetch] [--wait] [--timeout d] [--verbose|--trace] [--log-json]
+//	aoc verify [--manifest answers.txt] [--day N] [--part N] [--timeout d] [--verbose|--trace] [--log-json]
+//	aoc bench [--day N] [--part N] [--variant name] [--count N] [--save file] [--baseline file] [--threshold pct] [--timeout d] [--verbose|--trace] [--log-json]
+//	aoc gen <day> [--size N] [--seed S] [--out file]
+//	aoc minimize <day> --keep panic|error|mismatch [--part 1|2] [--variant name] [--input file | --fetch] [--match regexp] [--against variant] [--out file] [--timeout d] [--verbose|--trace] [--log-json]
+//	aoc new <day> [--title name] [--dir dir]
+//	aoc examples <day> --html page.html | --fetch [--dir dir]
+//	aoc calibrate [--vocab names] [--digits=false] [--input file] [-j N] [--chunk bytes] [--stats] [--explain ansi|html]
+//	aoc cubes possible|minimum|power|violations [--bag color=N,...] [--color color=N]... [--game N] [--input file]
+//	aoc crosscheck [--day N] [--part N] [--variant name] [--trials N] [--seed S] [--size N] [--timeout d] [--verbose|--trace] [--log-json]
+//
+// fetch, submit and --fetch talk to the puzzle site using the session cookie
+// from the AOC_SESSION environment variable or the config file (see
+// site.LoadConfig). Inputs and the history of submitted answers are kept in
+// the user cache directory.
+//
+// The commands that run solvers take a --timeout flag. A solver stopped by
+// its timeout or by an interrupt reports how far it got. They also take
+// --verbose and --trace, which make solvers log diagnostics to standard
+// error, as JSON lines with --log-json.
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	_ "adventofcode23/days"
+)
+
+const usage = `usage: aoc <command> [arguments]
+
+commands:
+  fetch <day>
+        download a day's puzzle input to the cache and print its path
+  list [--day N] [--part N] [--variant name]
+        list the registered solvers
+  run <day> [--part 1|2] [--variant name] [--input file | --fetch] [--format text|json|csv] [--timeout d] [--verbose|--trace] [--log-json]
+        solve one part of a day's puzzle
+  run-all [-j N] [--day N] [--part N] [--variant name|all] [--dir dir | --fetch] [--format text|json|csv] [--timeout d] [--verbose|--trace] [--log-json]
+        solve every day and part concurrently and summarize the results
+  submit <day> [--part 1|2] [--answer A | --variant name --input file | --fetch] [--wait] [--timeout d] [--verbose|--trace] [--log-json]
+        submit an answer, computing it with the solver unless --answer is given
+  verify [--manifest answers.txt] [--day N] [--part N] [--timeout d] [--verbose|--trace] [--log-json]
+        check solvers against the accepted answers
+  bench [--day N] [--part N] [--variant name] [--count N] [--save file] [--baseline file] [--threshold pct] [--timeout d] [--verbose|--trace] [--log-json]
+        time the solvers on the manifest inputs, optionally against a saved baseline
+  gen <day> [--size N] [--seed S] [--out file]
+        write a random puzzle input of the given size for stress testing
+  minimize <day> --keep panic|error|mismatch [--part 1|2] [--variant name] [--input file | --fetch] [--match regexp] [--against variant] [--out file] [--timeout d] [--verbose|--trace] [--log-json]
+        cut an input down to the smallest one that still panics, fails or disagrees
+  new <day> [--title name] [--dir dir]
+        start a new day's package from the templates, with its tests and answer stubs
+  examples <day> --html page.html | --fetch [--dir dir]
+        save the examples of a puzzle page, saved or downloaded, as fixtures checked by verify
+  calibrate [--vocab names] [--digits=false] [--input file] [-j N] [--chunk bytes] [--stats] [--explain ansi|html]
+        run the day 1 calibration with other vocabularies, built-in or from JSON files,
+        streaming large inputs in chunks, or showing how each line was read
+  cubes possible|minimum|power|violations [--bag color=N,...] [--color color=N]... [--game N] [--input file]
+        ask which day 2 games a bag allows, their minimal bags and powers, and what rules them out
+  crosscheck [--day N] [--part N] [--variant name] [--trials N] [--seed S] [--size N] [--timeout d] [--verbose|--trace] [--log-json]
+        check fast variants against their reference on generated inputs
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "fetch":
+		err = fetchCmd(ctx, args)
+	case "list":
+		err = listCmd(args)
+	case "run":
+		err = runCmd(ctx, args)
+	case "run-all":
+		err = runAllCmd(ctx, args)
+	case "submit":
+		err = submitCmd(ctx, args)
+	case "verify":
+		err = verifyCmd(ctx, args)
+	case "bench":
+		err = benchCmd(ctx, args)
+	case "gen":
+		err = genCmd(args)
+	case "minimize":
+		err = minimizeCmd(ctx, args)
+	case "new":
+		err = newCmd(args)
+	case "examples":
+		err = examplesCmd(ctx, args)
+	case "calibrate":
+		err = calibrateCmd(ctx, args)
+	case "cubes":
+		err = cubesCmd(args)
+	case "crosscheck":
+		err = crosscheckCmd(ctx, args)
+	case "help", "-h", "--help":
+		fmt.Print(usage)
+		return
+	default:
+		fmt.Fprintf(os.Stderr, "aoc: unknown command %q\n\n%s", cmd, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "aoc:", err)
+		os.Exit(1)
+	}
+}
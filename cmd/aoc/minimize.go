@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+	"adventofcode23/minimize"
+)
+
+func minimizeCmd(ctx context.Context, args []string) error {
+	day, args, err := parseDay(args)
+	if err != nil {
+		return err
+	}
+	var (
+		sf solveFlags
+		lf logFlags
+	)
+	fs := flag.NewFlagSet("minimize", flag.ContinueOnError)
+	sf.register(fs, day)
+	keep := fs.String("keep", "", "what the smaller input must still do: panic, error or mismatch")
+	match := fs.String("match", "", "with panic or error, a `regexp` the message must match")
+	against := fs.String("against", "", "with mismatch, the variant to disagree with (default the part's reference)")
+	out := fs.String("out", "", "file for the smallest input (default the input's name with .min added)")
+	lf.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ctx = lf.context(ctx)
+
+	solver, err := sf.solver()
+	if err != nil {
+		return err
+	}
+	var pattern *regexp.Regexp
+	if *match != "" {
+		if pattern, err = regexp.Compile(*match); err != nil {
+			return fmt.Errorf("--match: %w", err)
+		}
+	}
+	var (
+		holds minimize.Predicate
+		goal  string // what holds checks, for the error when it does not
+	)
+	switch *keep {
+	case "panic", "error":
+		holds = failsWith(solver, *keep == "panic", pattern, sf.timeout)
+		goal = "fail"
+		if *keep == "panic" {
+			goal = "panic"
+		}
+		if pattern != nil {
+			goal += fmt.Sprintf(" with a message matching %q", pattern)
+		}
+	case "mismatch":
+		other, ok := aoc.Reference(day, sf.part)
+		if *against != "" {
+			other, ok = aoc.Lookup(day, sf.part, *against)
+		}
+		if !ok {
+			return fmt.Errorf("no variant to compare %s with; name one with --against", aoc.Name(solver))
+		}
+		holds = disagrees(solver, other, sf.timeout)
+		goal = "disagree with " + aoc.Name(other)
+	default:
+		return fmt.Errorf("--keep must be panic, error or mismatch")
+	}
+
+	inputPath, err := sf.inputPath(ctx)
+	if err != nil {
+		return err
+	}
+	file, err := input.Open(day, inputPath)
+	if err != nil {
+		return err
+	}
+	data, err := io.ReadAll(file)
+	file.Close()
+	if err != nil {
+		return err
+	}
+
+	smallest, stats, err := minimize.Minimize(ctx, data, holds)
+	if errors.Is(err, minimize.ErrDoesNotHold) {
+		return fmt.Errorf("%s does not %s on %s", aoc.Name(solver), goal, inputPath)
+	}
+	if err != nil && len(smallest) == len(data) {
+		return err
+	}
+	if *out == "" {
+		*out = inputPath + ".min"
+		if inputPath == input.Stdin || inputPath == input.Example {
+			*out = fmt.Sprintf("day%d.min.txt", day)
+		}
+	}
+	if werr := os.WriteFile(*out, smallest, 0o644); werr != nil {
+		return werr
+	}
+	fmt.Printf("%d lines cut to %d after %d runs; wrote %s\n",
+		len(minimize.Lines(data)), len(minimize.Lines(smallest)), stats.Tests, *out)
+	return err
+}
+
+// failsWith holds when the solver fails, or only when it panics if panics
+// is set, with a message matching pattern if that is not nil. Running out
+// of time does not count: a smaller input that is merely slow is not the
+// failure being chased.
+func failsWith(solver aoc.Solver, panics bool, pattern *regexp.Regexp, timeout time.Duration) minimize.Predicate {
+	return func(ctx context.Context, in []byte) bool {
+		_, err := runOn(ctx, solver, in, timeout)
+		if err == nil || errors.Is(err, context.DeadlineExceeded) || interrupted(ctx, err) {
+			return false
+		}
+		var pe *panicError
+		if panics && !errors.As(err, &pe) {
+			return false
+		}
+		return pattern == nil || pattern.MatchString(err.Error())
+	}
+}
+
+// disagrees holds when solver's answer differs from other's on an input
+// that other solves, including when solver fails on it.
+func disagrees(solver, other aoc.Solver, timeout time.Duration) minimize.Predicate {
+	return func(ctx context.Context, in []byte) bool {
+		want, err := runOn(ctx, other, in, timeout)
+		if err != nil {
+			return false
+		}
+		got, err := runOn(ctx, solver, in, timeout)
+		if interrupted(ctx, err) {
+			return false
+		}
+		return err != nil || got.String() != want.String()
+	}
+}
+
+// interrupted reports whether a run was stopped by an interrupt rather than
+// failing on its own, so that it proves nothing about the input.
+func interrupted(ctx context.Context, err error) bool {
+	return ctx.Err() != nil || errors.Is(err, context.Canceled)
+}
+
+// runOn runs solver on in with safeSolve, stopping it after timeout if that
+// is positive.
+func runOn(ctx context.Context, solver aoc.Solver, in []byte, timeout time.Duration) (aoc.Answer, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	return safeSolve(ctx, solver, bytes.NewReader(in))
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"adventofcode23/aoc"
+)
+
+//go:embed templates
+var templates embed.FS
+
+// scaffold is the data the templates are filled in with.
+type scaffold struct {
+	Day    int
+	Title  string
+	Module string
+}
+
+func newCmd(args []string) error {
+	day, args, err := parseDay(args)
+	if err != nil {
+		return err
+	}
+	flags := flag.NewFlagSet("new", flag.ContinueOnError)
+	title := flags.String("title", "", "the puzzle's title (default \"Day N\")")
+	dir := flags.String("dir", ".", "root of the module to add the day to")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *title == "" {
+		*title = fmt.Sprintf("Day %d", day)
+	}
+	return newDay(os.Stdout, *dir, day, *title)
+}
+
+// newDay adds a package for day to the module at dir, links it into the
+// days package and stubs its answers, reporting what to fill in to w. If a
+// step fails, the steps before it are undone, so that it can be run again.
+func newDay(w io.Writer, dir string, day int, title string) (err error) {
+	module, err := modulePath(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return err
+	}
+	if len(aoc.Solvers(aoc.Filter{Day: day})) > 0 {
+		return fmt.Errorf("day %d already has solvers", day)
+	}
+	pkg := fmt.Sprintf("day%d", day)
+	pkgDir := filepath.Join(dir, pkg)
+	if err := os.Mkdir(pkgDir, 0o755); err != nil {
+		if errors.Is(err, fs.ErrExist) {
+			return fmt.Errorf("%s already exists; not overwriting it", pkgDir)
+		}
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.RemoveAll(pkgDir)
+		}
+	}()
+
+	data := scaffold{Day: day, Title: title, Module: module}
+	files := []struct{ tmpl, name string }{
+		{"day.go.tmpl", pkg + ".go"},
+		{"day_test.go.tmpl", pkg + "_test.go"},
+	}
+	for _, f := range files {
+		if err := render(f.tmpl, filepath.Join(pkgDir, f.name), data); err != nil {
+			return err
+		}
+	}
+	if err := writeNew(filepath.Join(pkgDir, "example.txt"), nil); err != nil {
+		return err
+	}
+
+	// The days package must not keep importing a package removed above.
+	daysFile := filepath.Join(dir, "days", "days.go")
+	daysSrc, err := os.ReadFile(daysFile)
+	if err != nil {
+		return err
+	}
+	if err := addImport(daysFile, module+"/"+pkg); err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			os.WriteFile(daysFile, daysSrc, 0o644)
+		}
+	}()
+	if err := addAnswerStubs(filepath.Join(dir, "answers.txt"), day); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "created %s: paste the example into %s/example.txt, its answers into %s_test.go, and the accepted answers into answers.txt\n",
+		pkgDir, pkg, pkg)
+	return nil
+}
+
+// modulePath reads the module path from a go.mod file.
+func modulePath(gomod string) (string, error) {
+	data, err := os.ReadFile(gomod)
+	if err != nil {
+		return "", err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if path, ok := strings.CutPrefix(strings.TrimSpace(scanner.Text()), "module "); ok {
+			return strings.Trim(strings.TrimSpace(path), `"`), nil
+		}
+	}
+	return "", fmt.Errorf("%s: no module line", gomod)
+}
+
+// render fills in a template and writes it, gofmt-ed, to a new file.
+func render(name, path string, data scaffold) error {
+	tmpl, err := template.ParseFS(templates, "templates/"+name)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return writeNew(path, src)
+}
+
+// writeNew writes a file that must not exist yet.
+func writeNew(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// addImport adds a blank import of pkg to the import block of the days
+// package, keeping the block sorted.
+func addImport(path, pkg string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	src := string(data)
+	start := strings.Index(src, "import (\n")
+	if start < 0 {
+		return fmt.Errorf("%s: no import block", path)
+	}
+	start += len("import (\n")
+	end := start + strings.Index(src[start:], "\n)")
+	if end < start {
+		return fmt.Errorf("%s: no end to the import block", path)
+	}
+	block := src[start:end]
+	imports := append(strings.Split(block, "\n"), fmt.Sprintf("\t_ %q", pkg))
+	sort.Strings(imports)
+	src = src[:start] + strings.Join(imports, "\n") + src[end:]
+
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return os.WriteFile(path, formatted, 0o644)
+}
+
+// addAnswerStubs appends commented-out manifest entries for a day, to be
+// filled in once its answers are accepted.
+func addAnswerStubs(path string, day int) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(f, "# %d\t1\tday%[1]d.txt\t?\n# %[1]d\t2\tday%[1]d.txt\t?\n", day)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const scratchDays = `// Package days links every day's solvers into the aoc registry.
+package days
+
+import (
+	_ "adventofcode23/input"
+)
+`
+
+const scratchAnswers = "# day\tpart\tinput\texpected\n"
+
+// scratchModule makes a module in a temporary directory with the packages
+// the templates import, a days package and a manifest.
+func scratchModule(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string][]byte{
+		"days/days.go": []byte(scratchDays),
+		"answers.txt":  []byte(scratchAnswers),
+	}
+	for _, pattern := range []string{"go.mod", "aoc/*.go", "input/*.go"} {
+		matches, err := filepath.Glob(filepath.Join("..", "..", pattern))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, m := range matches {
+			if strings.HasSuffix(m, "_test.go") {
+				continue
+			}
+			data, err := os.ReadFile(m)
+			if err != nil {
+				t.Fatal(err)
+			}
+			rel, _ := filepath.Rel(filepath.Join("..", ".."), m)
+			files[rel] = data
+		}
+	}
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, data, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+// TestNewDay scaffolds a day in a scratch module, builds and tests it, and
+// checks that a second run is refused.
+func TestNewDay(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds a scratch module")
+	}
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip(err)
+	}
+	dir := scratchModule(t)
+	if err := newDay(io.Discard, dir, 20, "Pulse Propagation"); err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command(goTool, "test", "./day20", "./days")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test in the scratch module: %v\n%s", err, out)
+	}
+
+	days, err := os.ReadFile(filepath.Join(dir, "days", "days.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(days), "\t_ \"adventofcode23/day20\"\n") {
+		t.Errorf("days.go does not import day20:\n%s", days)
+	}
+	answers, err := os.ReadFile(filepath.Join(dir, "answers.txt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := scratchAnswers + "# 20\t1\tday20.txt\t?\n# 20\t2\tday20.txt\t?\n"; string(answers) != want {
+		t.Errorf("answers.txt = %q, want %q", answers, want)
+	}
+
+	err = newDay(io.Discard, dir, 20, "Pulse Propagation")
+	if err == nil || !strings.Contains(err.Error(), "not overwriting") {
+		t.Errorf("second run: %v, want a refusal to overwrite", err)
+	}
+}
+
+// TestNewDayUndo checks that a failed scaffold leaves nothing behind that
+// would stop the next attempt.
+func TestNewDayUndo(t *testing.T) {
+	dir := scratchModule(t)
+	if err := os.Remove(filepath.Join(dir, "answers.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if err := newDay(io.Discard, dir, 20, "Pulse Propagation"); err == nil {
+		t.Fatal("newDay succeeded without a manifest")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "day20")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("day20 was left behind: %v", err)
+	}
+	days, err := os.ReadFile(filepath.Join(dir, "days", "days.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(days) != scratchDays {
+		t.Errorf("days.go was not restored:\n%s", days)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "answers.txt"), []byte(scratchAnswers), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := newDay(io.Discard, dir, 20, "Pulse Propagation"); err != nil {
+		t.Errorf("retry: %v", err)
+	}
+}
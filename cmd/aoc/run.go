@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime/debug"
+	"strconv"
+	"time"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+	"adventofcode23/report"
+)
+
+// parseDay reads the leading <day> argument and returns it with the remaining arguments.
+func parseDay(args []string) (int, []string, error) {
+	if len(args) == 0 {
+		return 0, nil, fmt.Errorf("missing <day> argument")
+	}
+	day, err := strconv.Atoi(args[0])
+	if err != nil || day < 1 || day > 25 {
+		return 0, nil, fmt.Errorf("invalid day %q", args[0])
+	}
+	return day, args[1:], nil
+}
+
+// solveFlags are the flags shared by the commands that run a solver.
+type solveFlags struct {
+	day     int
+	part    int
+	variant string
+	input   string
+	fetch   bool
+	timeout time.Duration
+}
+
+func (f *solveFlags) register(fs *flag.FlagSet, day int) {
+	f.day = day
+	fs.IntVar(&f.part, "part", 1, "puzzle part to solve (1 or 2)")
+	fs.StringVar(&f.variant, "variant", aoc.DefaultVariant, "implementation to use when a part has several")
+	fs.StringVar(&f.input, "input", fmt.Sprintf("day%d.txt", day), "puzzle input: a file, - for standard input, or example")
+	fs.BoolVar(&f.fetch, "fetch", false, "use the cached puzzle input, downloading it on first use")
+	fs.DurationVar(&f.timeout, "timeout", 0, "stop the solver after this long and report its progress (0 for no limit)")
+}
+
+// solve runs the selected solver on the selected input. The input is read
+// into memory first so that it can be hashed for the record.
+func (f *solveFlags) solve(ctx context.Context) (report.Record, error) {
+	solver, err := f.solver()
+	if err != nil {
+		return report.Record{}, err
+	}
+	inputPath, err := f.inputPath(ctx)
+	if err != nil {
+		return report.Record{}, err
+	}
+	rec, err := solveInput(ctx, solver, inputPath, f.timeout)
+	if err != nil {
+		return rec, fmt.Errorf("%s: %w", aoc.Name(solver), err)
+	}
+	return rec, nil
+}
+
+// solver returns the selected solver.
+func (f *solveFlags) solver() (aoc.Solver, error) {
+	solver, ok := aoc.Lookup(f.day, f.part, f.variant)
+	if !ok {
+		return nil, fmt.Errorf("no solver for day %d part %d variant %q", f.day, f.part, f.variant)
+	}
+	return solver, nil
+}
+
+// inputPath returns the selected input, downloading it first with --fetch.
+func (f *solveFlags) inputPath(ctx context.Context) (string, error) {
+	if f.fetch {
+		return fetchedInputPath(ctx, f.day)
+	}
+	return f.input, nil
+}
+
+// solveInput runs solver on an input, stopping it after timeout if that is
+// positive. The input is read into memory first so that it can be hashed
+// for the record. A panicking solver is reported as an error.
+func solveInput(ctx context.Context, solver aoc.Solver, inputPath string, timeout time.Duration) (report.Record, error) {
+	rec := report.Record{
+		Day:     solver.Day(),
+		Part:    solver.Part(),
+		Variant: solver.Variant(),
+		Input:   inputPath,
+	}
+	file, err := input.Open(solver.Day(), inputPath)
+	if err != nil {
+		return rec, err
+	}
+	data, err := io.ReadAll(file)
+	file.Close()
+	if err != nil {
+		return rec, err
+	}
+	rec.InputSHA256 = report.Hash(data)
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	start := time.Now()
+	answer, err := safeSolve(ctx, solver, bytes.NewReader(data))
+	rec.Duration = time.Since(start)
+	if err != nil {
+		name := inputPath
+		if name == input.Stdin {
+			name = "<stdin>"
+		}
+		return rec, input.WithFile(err, name)
+	}
+	rec.Answer = answer
+	return rec, nil
+}
+
+// panicError is the error safeSolve reports for a panic.
+type panicError struct {
+	value any
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.value)
+}
+
+// safeSolve is solver.Solve with a panic turned into an error, so that one
+// broken solver cannot take the others down with it. The stack is logged at
+// debug level.
+func safeSolve(ctx context.Context, solver aoc.Solver, r io.Reader) (answer aoc.Answer, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			aoc.Logger(ctx).Debug("solver panicked", "panic", p, "stack", string(debug.Stack()))
+			err = &panicError{value: p}
+		}
+	}()
+	return solver.Solve(ctx, r)
+}
+
+func runCmd(ctx context.Context, args []string) error {
+	day, args, err := parseDay(args)
+	if err != nil {
+		return err
+	}
+
+	var (
+		sf     solveFlags
+		lf     logFlags
+		format = report.Text
+	)
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	sf.register(fs, day)
+	lf.register(fs)
+	fs.Var(&format, "format", "output `format`: text (the answer alone), json or csv")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	rec, err := sf.solve(lf.context(ctx))
+	if err != nil {
+		return err
+	}
+	w := report.NewWriter(os.Stdout, format)
+	if err := w.Write(rec); err != nil {
+		return err
+	}
+	return w.Flush()
+}
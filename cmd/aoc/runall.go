@@ -0,0 +1,190 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"adventofcode23/aoc"
+	"adventofcode23/report"
+	"adventofcode23/site"
+)
+
+// job is one solver to run in run-all, and what came of it.
+type job struct {
+	solver aoc.Solver
+	input  string
+	skip   string // why the solver was not run, if it was not
+	rec    report.Record
+	err    error
+}
+
+func runAllCmd(ctx context.Context, args []string) error {
+	var (
+		f      aoc.Filter
+		lf     logFlags
+		format = report.Text
+	)
+	flags := flag.NewFlagSet("run-all", flag.ContinueOnError)
+	flags.IntVar(&f.Day, "day", 0, "only run this day")
+	flags.IntVar(&f.Part, "part", 0, "only run this part")
+	flags.StringVar(&f.Variant, "variant", aoc.DefaultVariant, "only run this variant, or all of them with \"all\"")
+	workers := flags.Int("j", runtime.NumCPU(), "number of solvers to run at once")
+	dir := flags.String("dir", ".", "directory holding the dayN.txt inputs")
+	fetch := flags.Bool("fetch", false, "use the cached puzzle inputs, downloading them on first use")
+	timeout := flags.Duration("timeout", 0, "stop each solver after this long and report its progress (0 for no limit)")
+	flags.Var(&format, "format", "`format` of the records: text (a summary table), json or csv")
+	lf.register(flags)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *workers < 1 {
+		return fmt.Errorf("-j must be at least 1")
+	}
+	if f.Variant == "all" {
+		f.Variant = ""
+	}
+	ctx = lf.context(ctx)
+
+	jobs, err := planJobs(ctx, aoc.Solvers(f), *dir, *fetch)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	runJobs(ctx, jobs, *workers, *timeout)
+	elapsed := time.Since(start)
+
+	summary := os.Stdout
+	if format != report.Text {
+		w := report.NewWriter(os.Stdout, format)
+		for _, j := range jobs {
+			if j.skip != "" {
+				continue
+			}
+			if err := w.Write(j.rec); err != nil {
+				return err
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		summary = os.Stderr
+	}
+	if err := writeSummary(summary, jobs, elapsed); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, j := range jobs {
+		if j.err != nil {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d solvers failed", failed, len(jobs))
+	}
+	return nil
+}
+
+// planJobs picks the input for each solver: the cached download with fetch,
+// otherwise dayN.txt in dir. Solvers whose input file is missing are
+// skipped. Downloads happen here, one at a time, so that the site's rate
+// limit is respected.
+func planJobs(ctx context.Context, solvers []aoc.Solver, dir string, fetch bool) ([]*job, error) {
+	var client *site.Client
+	if fetch {
+		cfg, err := site.LoadConfig()
+		if err != nil {
+			return nil, err
+		}
+		client = site.NewClient(cfg)
+	}
+
+	inputs := make(map[int]string)
+	jobs := make([]*job, len(solvers))
+	for i, s := range solvers {
+		j := &job{solver: s}
+		jobs[i] = j
+		path, ok := inputs[s.Day()]
+		if !ok {
+			if client != nil {
+				p, err := client.InputPath(ctx, s.Day())
+				if err != nil {
+					return nil, fmt.Errorf("day %d: %w", s.Day(), err)
+				}
+				path = p
+			} else {
+				path = filepath.Join(dir, fmt.Sprintf("day%d.txt", s.Day()))
+			}
+			inputs[s.Day()] = path
+		}
+		j.input = path
+		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+			j.skip = "no input"
+		}
+	}
+	return jobs, nil
+}
+
+// runJobs runs the jobs that are not skipped on a pool of workers.
+func runJobs(ctx context.Context, jobs []*job, workers int, timeout time.Duration) {
+	queue := make(chan *job)
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range queue {
+				log := aoc.Logger(ctx).With("solver", aoc.Name(j.solver))
+				j.rec, j.err = solveInput(aoc.WithLogger(ctx, log), j.solver, j.input, timeout)
+				if j.err != nil {
+					j.rec.Error = j.err.Error()
+				}
+			}
+		}()
+	}
+	for _, j := range jobs {
+		if j.skip == "" {
+			queue <- j
+		}
+	}
+	close(queue)
+	wg.Wait()
+}
+
+// writeSummary prints a table of every job followed by a count of the
+// outcomes.
+func writeSummary(w io.Writer, jobs []*job, elapsed time.Duration) error {
+	solved, failed, skipped := 0, 0, 0
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SOLVER\tINPUT\tANSWER\tTIME\tSTATUS")
+	for _, j := range jobs {
+		name, in := aoc.Name(j.solver), filepath.Base(j.input)
+		switch {
+		case j.skip != "":
+			skipped++
+			fmt.Fprintf(tw, "%s\t%s\t-\t-\tskipped: %s\n", name, in, j.skip)
+		case j.err != nil:
+			failed++
+			fmt.Fprintf(tw, "%s\t%s\t-\t%s\tFAILED: %v\n", name, in, j.rec.Duration.Round(time.Microsecond), j.err)
+		default:
+			solved++
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\tok\n", name, in, j.rec.Answer, j.rec.Duration.Round(time.Microsecond))
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(w, "%d solved, %d failed, %d skipped in %s\n", solved, failed, skipped, elapsed.Round(time.Millisecond))
+	return err
+}
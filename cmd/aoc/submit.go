@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"adventofcode23/site"
+)
+
+func submitCmd(ctx context.Context, args []string) error {
+	day, args, err := parseDay(args)
+	if err != nil {
+		return err
+	}
+
+	var (
+		sf solveFlags
+		lf logFlags
+	)
+	fs := flag.NewFlagSet("submit", flag.ContinueOnError)
+	sf.register(fs, day)
+	lf.register(fs)
+	answer := fs.String("answer", "", "answer to submit instead of running the solver")
+	wait := fs.Bool("wait", false, "sleep through a running cooldown instead of giving up")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *answer == "" {
+		rec, err := sf.solve(lf.context(ctx))
+		if err != nil {
+			return err
+		}
+		*answer = rec.Answer.String()
+	}
+
+	cfg, err := site.LoadConfig()
+	if err != nil {
+		return err
+	}
+	client := site.NewClient(cfg)
+
+	outcome, err := client.Submit(ctx, day, sf.part, *answer)
+	var cooldown *site.CooldownError
+	if errors.As(err, &cooldown) && *wait {
+		fmt.Printf("waiting until %s\n", cooldown.Until.Format(time.TimeOnly))
+		select {
+		case <-time.After(time.Until(cooldown.Until)):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		outcome, err = client.Submit(ctx, day, sf.part, *answer)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("day %d part %d: %s: %s\n", day, sf.part, *answer, outcome.Verdict)
+	if outcome.Wait > 0 {
+		fmt.Printf("next submission allowed in %s\n", outcome.Wait)
+	}
+	if outcome.Verdict != site.Correct && outcome.Verdict != site.AlreadySolved {
+		return fmt.Errorf("answer not accepted: %s", outcome.Message)
+	}
+	return nil
+}
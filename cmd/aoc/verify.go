@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"adventofcode23/verify"
+)
+
+func verifyCmd(ctx context.Context, args []string) error {
+	fs := flag.NewFlagSet("verify", flag.ContinueOnError)
+	manifest := fs.String("manifest", "answers.txt", "answers manifest")
+	day := fs.Int("day", 0, "only verify this day")
+	part := fs.Int("part", 0, "only verify this part")
+	timeout := fs.Duration("timeout", 0, "stop each solver after this long and report its progress (0 for no limit)")
+	var lf logFlags
+	lf.register(fs)
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	ctx = lf.context(ctx)
+
+	entries, err := verify.Load(*manifest)
+	if err != nil {
+		return err
+	}
+
+	var results []verify.Result
+	for _, e := range entries {
+		if (*day != 0 && e.Day != *day) || (*part != 0 && e.Part != *part) {
+			continue
+		}
+		results = append(results, verify.Check(ctx, e, *timeout)...)
+	}
+	if err := verify.WriteTable(os.Stdout, results); err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, r := range results {
+		if !r.OK() {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d answers differ from %s", failed, len(results), *manifest)
+	}
+	return nil
+}
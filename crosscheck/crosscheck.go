@@ -0,0 +1,192 @@
+// Package crosscheck tests the fast variants of a part against its reference
+// implementation (see aoc.RegisterReference) on generated inputs, and
+// reports the smallest generated input they disagree on.
+package crosscheck
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"text/tabwriter"
+	"time"
+
+	"adventofcode23/aoc"
+)
+
+// Pair is a fast variant and the reference it is checked against.
+type Pair struct {
+	Reference, Fast aoc.Solver
+}
+
+// Pairs returns a Pair for every variant that matches f and is not the
+// reference of its part, for the parts that have a reference and an input
+// generator.
+func Pairs(f aoc.Filter) []Pair {
+	var pairs []Pair
+	for _, s := range aoc.Solvers(f) {
+		ref, ok := aoc.Reference(s.Day(), s.Part())
+		if !ok || ref.Variant() == s.Variant() {
+			continue
+		}
+		if _, ok := aoc.LookupGenerator(s.Day()); ok {
+			pairs = append(pairs, Pair{Reference: ref, Fast: s})
+		}
+	}
+	return pairs
+}
+
+// Config says which inputs to try.
+type Config struct {
+	// Seed is the seed of the first trial; trial i uses Seed+i.
+	Seed   int64
+	Trials int
+	// MaxSize is the largest input size; trial i generates size
+	// 1+i%MaxSize, so small inputs come up often. A part's registered
+	// size limit (see aoc.RegisterSizeLimit) lowers it.
+	MaxSize int
+	// Timeout, if positive, limits each solver's run.
+	Timeout time.Duration
+}
+
+// Mismatch is an input on which the fast variant's answer differs from the
+// reference's. `aoc gen <day> --seed <Seed> --size <Size>` writes it again.
+type Mismatch struct {
+	Seed  int64
+	Size  int
+	Input []byte
+	Want  aoc.Answer
+	Got   aoc.Answer
+	Err   error // the fast variant's error, when it failed
+}
+
+// Result is the outcome of checking one pair.
+type Result struct {
+	Pair
+	Trials int
+	// Skipped counts the trials the reference itself failed, usually by
+	// running out of time, which therefore prove nothing.
+	Skipped int
+	// Mismatch is the disagreement on the smallest input, or nil.
+	Mismatch *Mismatch
+}
+
+// Run checks p on cfg.Trials generated inputs. It stops at the first
+// disagreement and shrinks it by regenerating the same seed at each smaller
+// size. The error is only set when ctx is done or the generator fails.
+func Run(ctx context.Context, p Pair, cfg Config) (Result, error) {
+	res := Result{Pair: p}
+	gen, ok := aoc.LookupGenerator(p.Fast.Day())
+	if !ok {
+		return res, fmt.Errorf("no input generator for day %d", p.Fast.Day())
+	}
+	maxSize := max(1, cfg.MaxSize)
+	if limit, ok := aoc.SizeLimit(p.Fast.Day(), p.Fast.Part()); ok {
+		maxSize = min(maxSize, limit)
+	}
+
+	for i := 0; i < cfg.Trials; i++ {
+		seed, size := cfg.Seed+int64(i), 1+i%maxSize
+		m, skipped, err := try(ctx, p, gen, seed, size, cfg.Timeout)
+		if err != nil {
+			return res, err
+		}
+		res.Trials++
+		if skipped {
+			res.Skipped++
+			continue
+		}
+		if m == nil {
+			continue
+		}
+
+		for smaller := 1; smaller < size; smaller++ {
+			sm, _, err := try(ctx, p, gen, seed, smaller, cfg.Timeout)
+			if err != nil {
+				return res, err
+			}
+			if sm != nil {
+				m = sm
+				break
+			}
+		}
+		res.Mismatch = m
+		return res, nil
+	}
+	return res, nil
+}
+
+// try runs both solvers on one generated input. It returns the mismatch, if
+// any, and whether the reference failed so that the input proves nothing.
+func try(ctx context.Context, p Pair, gen aoc.GenerateFunc, seed int64, size int, timeout time.Duration) (*Mismatch, bool, error) {
+	var buf bytes.Buffer
+	if err := gen(&buf, size, rand.New(rand.NewSource(seed))); err != nil {
+		return nil, false, fmt.Errorf("generating day %d seed %d size %d: %w", p.Fast.Day(), seed, size, err)
+	}
+	in := buf.Bytes()
+
+	want, err := solve(ctx, p.Reference, in, timeout)
+	if ctx.Err() != nil {
+		return nil, false, ctx.Err()
+	}
+	if err != nil {
+		aoc.Logger(ctx).Debug("reference failed", "solver", aoc.Name(p.Reference), "seed", seed, "size", size, "err", err)
+		return nil, true, nil
+	}
+	got, err := solve(ctx, p.Fast, in, timeout)
+	if ctx.Err() != nil {
+		return nil, false, ctx.Err()
+	}
+	if err == nil && got.String() == want.String() {
+		return nil, false, nil
+	}
+	return &Mismatch{Seed: seed, Size: size, Input: in, Want: want, Got: got, Err: err}, false, nil
+}
+
+// solve runs s on in with a panic turned into an error, since a panic is
+// as much a disagreement as a wrong answer.
+func solve(ctx context.Context, s aoc.Solver, in []byte, timeout time.Duration) (answer aoc.Answer, err error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			err = fmt.Errorf("panic: %v", p)
+		}
+	}()
+	return s.Solve(ctx, bytes.NewReader(in))
+}
+
+// WriteTable prints one row per result, then each mismatch with the command
+// that regenerates its input and the input itself.
+func WriteTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "SOLVER\tREFERENCE\tTRIALS\tSKIPPED\tRESULT")
+	for _, r := range results {
+		status := "ok"
+		if r.Mismatch != nil {
+			status = fmt.Sprintf("MISMATCH at seed %d size %d", r.Mismatch.Seed, r.Mismatch.Size)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%d\t%s\n", aoc.Name(r.Fast), aoc.Name(r.Reference), r.Trials, r.Skipped, status)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		m := r.Mismatch
+		if m == nil {
+			continue
+		}
+		got := m.Got.String()
+		if m.Err != nil {
+			got = "error: " + m.Err.Error()
+		}
+		fmt.Fprintf(w, "\n%s: got %s, %s wants %s on `aoc gen %d --seed %d --size %d`:\n%s",
+			aoc.Name(r.Fast), got, aoc.Name(r.Reference), m.Want, r.Fast.Day(), m.Seed, m.Size, m.Input)
+	}
+	return nil
+}
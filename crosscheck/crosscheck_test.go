@@ -0,0 +1,92 @@
+package crosscheck
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"math/rand"
+	"testing"
+	"time"
+
+	"adventofcode23/aoc"
+	_ "adventofcode23/days"
+)
+
+// TestRegistered checks every registered fast variant against its reference.
+func TestRegistered(t *testing.T) {
+	pairs := Pairs(aoc.Filter{})
+	if len(pairs) == 0 {
+		t.Fatal("no variants with a reference")
+	}
+	for _, p := range pairs {
+		p := p
+		t.Run(aoc.Name(p.Fast), func(t *testing.T) {
+			t.Parallel()
+			res, err := Run(context.Background(), p, Config{Seed: 1, Trials: 20, MaxSize: 8, Timeout: 10 * time.Second})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Mismatch != nil {
+				var buf bytes.Buffer
+				WriteTable(&buf, []Result{res})
+				t.Fatalf("mismatch:\n%s", buf.String())
+			}
+		})
+	}
+}
+
+// TestPairs checks that the fast variants the references were added for are
+// paired with them.
+func TestPairs(t *testing.T) {
+	want := []string{"1/1/parallel", "1/2/parallel", "6/1/quadratic", "6/2/quadratic", "11/1/factor", "11/2/default"}
+	got := map[string]string{}
+	for _, p := range Pairs(aoc.Filter{}) {
+		got[aoc.Name(p.Fast)] = aoc.Name(p.Reference)
+	}
+	for _, name := range want {
+		if _, ok := got[name]; !ok {
+			t.Errorf("no pair for %s; pairs are %v", name, got)
+		}
+	}
+}
+
+// TestShrink checks that a mismatch is reported on the smallest size of its
+// seed. The broken variant is wrong whenever the input contains a 7, and the
+// day 1 generator writes the same first lines for a seed at every size.
+func TestShrink(t *testing.T) {
+	length := func(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+		data, err := io.ReadAll(r)
+		return aoc.Int(len(data)), err
+	}
+	broken := func(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+		data, err := io.ReadAll(r)
+		if bytes.IndexByte(data, '7') >= 0 {
+			return aoc.Int(-1), err
+		}
+		return aoc.Int(len(data)), err
+	}
+	p := Pair{
+		Reference: aoc.NewVariant(1, 1, "length", "", length),
+		Fast:      aoc.NewVariant(1, 1, "broken", "", broken),
+	}
+
+	res, err := Run(context.Background(), p, Config{Seed: 1, Trials: 100, MaxSize: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := res.Mismatch
+	if m == nil {
+		t.Fatal("no mismatch found")
+	}
+	if !bytes.Contains(m.Input, []byte("7")) {
+		t.Fatalf("mismatch input has no 7:\n%s", m.Input)
+	}
+	if m.Size > 1 {
+		gen, _ := aoc.LookupGenerator(1)
+		var smaller bytes.Buffer
+		gen(&smaller, m.Size-1, rand.New(rand.NewSource(m.Seed)))
+		if bytes.Contains(smaller.Bytes(), []byte("7")) {
+			t.Errorf("mismatch at size %d, but size %d also has a 7", m.Size, m.Size-1)
+		}
+	}
+}
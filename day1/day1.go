@@ -0,0 +1,67 @@
+// Package day1 solves Day 1: Trebuchet?!
+package day1
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+const title = "Trebuchet?!"
+
+func init() {
+	aoc.RegisterParser(1, aoc.ParseOnly(input.Lines))
+	aoc.RegisterGenerator(1, generate)
+	aoc.Register(aoc.New(1, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(1, 2, title, aoc.IntFunc(Part2)))
+	aoc.Register(aoc.NewVariant(1, 1, "parallel", title, aoc.IntFunc(Part1Parallel)))
+	aoc.Register(aoc.NewVariant(1, 2, "parallel", title, aoc.IntFunc(Part2Parallel)))
+	aoc.RegisterReference(1, 1, aoc.DefaultVariant)
+	aoc.RegisterReference(1, 2, aoc.DefaultVariant)
+}
+
+// Part1 sums the calibration values built from the first and last digit of each line.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	total := 0
+	scanner := input.NewScanner(r)
+	for n := 0; scanner.Scan(); n++ {
+		if n%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "line %d", n+1); err != nil {
+				return 0, err
+			}
+		}
+		line := scanner.Text()
+		calibrationValue := getCalibrationValue(line)
+		total += calibrationValue
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("reading calibration document: %w", err)
+	}
+
+	return total, nil
+}
+
+// getCalibrationValue reads the ASCII digits of s, the same ones the Literal
+// vocabulary matches, so that Part1 and Part1Parallel agree.
+func getCalibrationValue(s string) int {
+	firstDigit, lastDigit := -1, -1
+
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c >= '0' && c <= '9' {
+			digit := int(c - '0')
+			if firstDigit == -1 {
+				firstDigit = digit
+			}
+			lastDigit = digit
+		}
+	}
+
+	if firstDigit == -1 {
+		return 0
+	}
+	return firstDigit*10 + lastDigit
+}
@@ -0,0 +1,22 @@
+package day1
+
+import (
+	"context"
+	"io"
+)
+
+// spelled matches the digits and their English names.
+var spelled = Compile(mustMerge(Literal, Presets["english"]))
+
+// Part2 is Part1 with spelled-out digits ("one", "two", ...) counting as digits too.
+func Part2(ctx context.Context, r io.Reader) (int, error) {
+	return Calibrate(ctx, r, spelled)
+}
+
+func mustMerge(vocabs ...Vocabulary) Vocabulary {
+	v, err := Merge(vocabs...)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,118 @@
+package day1
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"strings"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+// Line is how one line of a calibration document was read.
+type Line struct {
+	Number int // counted from 1
+	Text   string
+	// First and Last are the tokens the value was built from; they are the
+	// same token when the line has only one.
+	First, Last Match
+	Value       int
+	// Found is false for a line without any token, which adds 0 to the
+	// total.
+	Found bool
+}
+
+// Explain reads r like Calibrate and calls fn with each line as it goes,
+// returning the total.
+func Explain(ctx context.Context, r io.Reader, m *Matcher, fn func(Line) error) (int, error) {
+	total := 0
+	scanner := input.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		if (n-1)%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "line %d", n); err != nil {
+				return total, err
+			}
+		}
+		l := Line{Number: n, Text: scanner.Text()}
+		l.First, l.Found = m.First(l.Text)
+		if l.Found {
+			l.Last, _ = m.Last(l.Text)
+			l.Value = l.First.Digit*10 + l.Last.Digit
+		}
+		total += l.Value
+		if err := fn(l); err != nil {
+			return total, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("reading calibration document: %w", err)
+	}
+	return total, nil
+}
+
+// Style is how Highlight marks the tokens of a line.
+type Style int
+
+const (
+	ANSI Style = iota // terminal colours: green first, cyan last, magenta both
+	HTML              // <mark class="first">, "last" or "first last", escaped
+)
+
+// Highlight returns the text of l with its first and last tokens marked.
+func (l Line) Highlight(style Style) string {
+	if !l.Found {
+		if style == HTML {
+			return html.EscapeString(l.Text)
+		}
+		return l.Text
+	}
+	// role is 1 for bytes of the first token, 2 for the last, 3 for both,
+	// as "oneight" shares its "e".
+	role := func(i int) int {
+		r := 0
+		if i >= l.First.Start && i < l.First.End {
+			r |= 1
+		}
+		if i >= l.Last.Start && i < l.Last.End {
+			r |= 2
+		}
+		return r
+	}
+	var b strings.Builder
+	for i := 0; i < len(l.Text); {
+		r := role(i)
+		j := i + 1
+		for j < len(l.Text) && role(j) == r {
+			j++
+		}
+		b.WriteString(mark(l.Text[i:j], r, style))
+		i = j
+	}
+	return b.String()
+}
+
+func mark(s string, role int, style Style) string {
+	if style == HTML {
+		s = html.EscapeString(s)
+		switch role {
+		case 1:
+			return `<mark class="first">` + s + "</mark>"
+		case 2:
+			return `<mark class="last">` + s + "</mark>"
+		case 3:
+			return `<mark class="first last">` + s + "</mark>"
+		}
+		return s
+	}
+	switch role {
+	case 1:
+		return "\x1b[1;32m" + s + "\x1b[0m"
+	case 2:
+		return "\x1b[1;36m" + s + "\x1b[0m"
+	case 3:
+		return "\x1b[1;35m" + s + "\x1b[0m"
+	}
+	return s
+}
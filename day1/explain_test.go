@@ -0,0 +1,37 @@
+package day1
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestExplain(t *testing.T) {
+	var lines []Line
+	total, err := Explain(context.Background(), strings.NewReader("oneight\nnothing\nx<7\n"), spelled, func(l Line) error {
+		lines = append(lines, l)
+		return nil
+	})
+	if err != nil || total != 18+77 || len(lines) != 3 {
+		t.Fatalf("Explain = %d, %v with %d lines; want 95 with 3", total, err, len(lines))
+	}
+	if lines[1].Found || lines[1].Number != 2 {
+		t.Errorf("line 2 = %+v, want it flagged as without digits", lines[1])
+	}
+
+	tests := []struct {
+		line  Line
+		style Style
+		want  string
+	}{
+		{lines[0], HTML, `<mark class="first">on</mark><mark class="first last">e</mark><mark class="last">ight</mark>`},
+		{lines[2], HTML, `x&lt;<mark class="first last">7</mark>`},
+		{lines[1], HTML, "nothing"},
+		{lines[0], ANSI, "\x1b[1;32mon\x1b[0m\x1b[1;35me\x1b[0m\x1b[1;36might\x1b[0m"},
+	}
+	for _, tt := range tests {
+		if got := tt.line.Highlight(tt.style); got != tt.want {
+			t.Errorf("Highlight(%q) = %q, want %q", tt.line.Text, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package day1
+
+import (
+	"bufio"
+	"io"
+	"math/rand"
+)
+
+var digitWords = []string{"one", "two", "three", "four", "five", "six", "seven", "eight", "nine"}
+
+// generate writes size calibration lines of letters, digits and spelled-out
+// digits. Every line holds at least one digit, so both parts have a value
+// for it.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	bw := bufio.NewWriter(w)
+	for i := 0; i < size; i++ {
+		pieces := 1 + rng.Intn(8)
+		digit := rng.Intn(pieces) // where the guaranteed digit goes
+		for j := 0; j < pieces; j++ {
+			switch {
+			case j == digit:
+				bw.WriteByte(byte('1' + rng.Intn(9)))
+			case rng.Intn(4) == 0:
+				bw.WriteString(digitWords[rng.Intn(len(digitWords))])
+			case rng.Intn(3) == 0:
+				bw.WriteByte(byte('1' + rng.Intn(9)))
+			default:
+				for k := 1 + rng.Intn(4); k > 0; k-- {
+					bw.WriteByte(byte('a' + rng.Intn(26)))
+				}
+			}
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
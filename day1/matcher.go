@@ -0,0 +1,176 @@
+package day1
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+// Match is one digit token found in a line: the bytes line[Start:End] spell
+// Digit.
+type Match struct {
+	Start, End int
+	Digit      int
+}
+
+// Matcher finds every digit token in a line in a single pass. It is an
+// Aho-Corasick automaton over the bytes of its patterns, with the failure
+// links folded into the transition table, so overlapping tokens such as the
+// "eight" and "two" of "eightwo" are all reported.
+type Matcher struct {
+	next [][256]int32
+	// out lists the patterns ending at each state, longest first.
+	out    [][]int32
+	lens   []int
+	digits []int
+	maxLen int
+}
+
+// Compile builds a Matcher for the words of a vocabulary. Empty words are
+// ignored.
+func Compile(vocab Vocabulary) *Matcher {
+	words := make([]string, 0, len(vocab))
+	for w := range vocab {
+		if w != "" {
+			words = append(words, w)
+		}
+	}
+	sort.Strings(words) // so that state numbers do not depend on map order
+
+	m := &Matcher{next: make([][256]int32, 1), out: make([][]int32, 1)}
+	for i, w := range words {
+		s := int32(0)
+		for j := 0; j < len(w); j++ {
+			if m.next[s][w[j]] == 0 {
+				m.next = append(m.next, [256]int32{})
+				m.out = append(m.out, nil)
+				m.next[s][w[j]] = int32(len(m.next) - 1)
+			}
+			s = m.next[s][w[j]]
+		}
+		m.out[s] = []int32{int32(i)}
+		m.lens = append(m.lens, len(w))
+		m.digits = append(m.digits, vocab[w])
+		m.maxLen = max(m.maxLen, len(w))
+	}
+
+	// Breadth first, every state's failure state is shallower and already
+	// complete, so a missing transition can borrow the failure state's.
+	fail := make([]int32, len(m.next))
+	queue := make([]int32, 0, len(m.next))
+	for b := range m.next[0] {
+		if c := m.next[0][b]; c != 0 {
+			queue = append(queue, c)
+		}
+	}
+	for len(queue) > 0 {
+		s := queue[0]
+		queue = queue[1:]
+		f := fail[s]
+		for b := range m.next[s] {
+			c := m.next[s][b]
+			if c == 0 {
+				m.next[s][b] = m.next[f][b]
+				continue
+			}
+			fail[c] = m.next[f][b]
+			m.out[c] = append(m.out[c], m.out[fail[c]]...)
+			queue = append(queue, c)
+		}
+	}
+	return m
+}
+
+// Scan calls yield for every token in line, in order of where they end, and
+// stops early if yield returns false.
+func (m *Matcher) Scan(line string, yield func(Match) bool) {
+	s := int32(0)
+	for i := 0; i < len(line); i++ {
+		s = m.next[s][line[i]]
+		for _, p := range m.out[s] {
+			if !yield(Match{Start: i + 1 - m.lens[p], End: i + 1, Digit: m.digits[p]}) {
+				return
+			}
+		}
+	}
+}
+
+// Matches returns every token in line, overlapping ones included.
+func (m *Matcher) Matches(line string) []Match {
+	var matches []Match
+	m.Scan(line, func(t Match) bool {
+		matches = append(matches, t)
+		return true
+	})
+	return matches
+}
+
+// Digits returns the digits of every token in line, in order.
+func (m *Matcher) Digits(line string) []int {
+	var digits []int
+	m.Scan(line, func(t Match) bool {
+		digits = append(digits, t.Digit)
+		return true
+	})
+	return digits
+}
+
+// First returns the token that starts first in line, the longest of them if
+// several do, as "VIII" should not read as "V". It stops reading once no
+// later token could start earlier.
+func (m *Matcher) First(line string) (first Match, ok bool) {
+	m.Scan(line, func(t Match) bool {
+		if !ok || t.Start < first.Start || t.Start == first.Start && t.End > first.End {
+			first, ok = t, true
+		}
+		return t.End < first.Start+m.maxLen
+	})
+	return first, ok
+}
+
+// Last returns the token that ends last in line, the longest of them if
+// several do.
+func (m *Matcher) Last(line string) (last Match, ok bool) {
+	m.Scan(line, func(t Match) bool {
+		if !ok || t.End > last.End {
+			last, ok = t, true
+		}
+		return true
+	})
+	return last, ok
+}
+
+// Value returns the calibration value of line, the digit of its first token
+// followed by the digit of its last, and false if it has no tokens.
+func (m *Matcher) Value(line string) (int, bool) {
+	first, ok := m.First(line)
+	if !ok {
+		return 0, false
+	}
+	last, _ := m.Last(line)
+	return first.Digit*10 + last.Digit, true
+}
+
+// Calibrate sums the calibration values of the lines of r. Lines without
+// tokens count as 0.
+func Calibrate(ctx context.Context, r io.Reader, m *Matcher) (int, error) {
+	total := 0
+	scanner := input.NewScanner(r)
+	for n := 0; scanner.Scan(); n++ {
+		if n%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "line %d", n+1); err != nil {
+				return 0, err
+			}
+		}
+		value, _ := m.Value(scanner.Text())
+		total += value
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("reading calibration document: %w", err)
+	}
+	return total, nil
+}
@@ -0,0 +1,70 @@
+package day1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMatcher(t *testing.T) {
+	tests := []struct {
+		line        string
+		digits      []int
+		first, last Match
+	}{
+		{"two1nine", []int{2, 1, 9}, Match{0, 3, 2}, Match{4, 8, 9}},
+		{"eightwothree", []int{8, 2, 3}, Match{0, 5, 8}, Match{7, 12, 3}},
+		{"xtwone3four", []int{2, 1, 3, 4}, Match{1, 4, 2}, Match{7, 11, 4}},
+		{"oneight", []int{1, 8}, Match{0, 3, 1}, Match{2, 7, 8}},
+		{"7pqrstsixteen", []int{7, 6}, Match{0, 1, 7}, Match{6, 9, 6}},
+		{"sevenine", []int{7, 9}, Match{0, 5, 7}, Match{4, 8, 9}},
+	}
+	for _, tt := range tests {
+		if got := spelled.Digits(tt.line); !reflect.DeepEqual(got, tt.digits) {
+			t.Errorf("Digits(%q) = %v, want %v", tt.line, got, tt.digits)
+		}
+		if got, ok := spelled.First(tt.line); !ok || got != tt.first {
+			t.Errorf("First(%q) = %v, %v; want %v", tt.line, got, ok, tt.first)
+		}
+		if got, ok := spelled.Last(tt.line); !ok || got != tt.last {
+			t.Errorf("Last(%q) = %v, %v; want %v", tt.line, got, ok, tt.last)
+		}
+	}
+
+	if _, ok := spelled.First("abcdef"); ok {
+		t.Error("First found a digit in a line without any")
+	}
+}
+
+// A token inside a longer one must lose to it at either end.
+func TestMatcherNested(t *testing.T) {
+	m := Compile(Vocabulary{"abcd": 1, "bc": 2})
+	if got, _ := m.First("xabcd"); got != (Match{1, 5, 1}) {
+		t.Errorf("First = %v, want the abcd token", got)
+	}
+	if got := m.Matches("xabcd"); len(got) != 2 {
+		t.Errorf("Matches = %v, want both tokens", got)
+	}
+
+	roman := Compile(Presets["roman"])
+	for line, want := range map[string]int{"VIII": 88, "xIVx": 44, "VI IX": 69, "I": 11} {
+		if got, ok := roman.Value(line); !ok || got != want {
+			t.Errorf("roman Value(%q) = %d, %v; want %d", line, got, ok, want)
+		}
+	}
+}
+
+func TestMerge(t *testing.T) {
+	v, err := Merge(Literal, Presets["chinese"], Presets["german"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, ok := Compile(v).Value("x三und2fünfzig"); !ok || got != 35 {
+		t.Errorf("Value = %d, %v; want 35", got, ok)
+	}
+	if _, err := Merge(Presets["english"], Vocabulary{"one": 7}); err == nil {
+		t.Error("Merge accepted a word with two digits")
+	}
+	if _, err := Merge(Vocabulary{"ten": 10}); err == nil {
+		t.Error("Merge accepted a word that is not a digit")
+	}
+}
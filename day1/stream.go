@@ -0,0 +1,177 @@
+package day1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// literal matches the digits alone, as Part1 reads them.
+var literal = Compile(Literal)
+
+// Part1Parallel is Part1 summed in chunks on every core.
+func Part1Parallel(ctx context.Context, r io.Reader) (int, error) {
+	total, _, err := CalibrateParallel(ctx, r, literal, StreamConfig{})
+	return total, err
+}
+
+// Part2Parallel is Part2 summed in chunks on every core.
+func Part2Parallel(ctx context.Context, r io.Reader) (int, error) {
+	total, _, err := CalibrateParallel(ctx, r, spelled, StreamConfig{})
+	return total, err
+}
+
+// DefaultChunkSize is the chunk size CalibrateParallel uses when none is
+// given.
+const DefaultChunkSize = 1 << 20
+
+// StreamConfig says how CalibrateParallel splits up its input.
+type StreamConfig struct {
+	// Workers is the number of goroutines summing chunks, runtime.NumCPU()
+	// if zero.
+	Workers int
+	// ChunkSize is the size of the buffers the input is read into,
+	// DefaultChunkSize if zero. A chunk ends at the last newline in its
+	// buffer, so a buffer only grows for a line longer than itself.
+	ChunkSize int
+}
+
+// StreamStats describes a CalibrateParallel run.
+type StreamStats struct {
+	Bytes, Lines int64
+	Chunks       int
+	Workers      int
+	Elapsed      time.Duration
+}
+
+// Throughput returns the bytes read per second.
+func (s StreamStats) Throughput() float64 {
+	if s.Elapsed <= 0 {
+		return 0
+	}
+	return float64(s.Bytes) / s.Elapsed.Seconds()
+}
+
+func (s StreamStats) String() string {
+	return fmt.Sprintf("%d bytes, %d lines in %d chunks on %d workers in %v: %.1f MB/s",
+		s.Bytes, s.Lines, s.Chunks, s.Workers, s.Elapsed.Round(time.Millisecond), s.Throughput()/1e6)
+}
+
+// CalibrateParallel is Calibrate for inputs too large to read on one core.
+// It reads r into chunks that end at newline boundaries and sums them on
+// cfg.Workers goroutines. Memory is bounded by two buffers per worker.
+func CalibrateParallel(ctx context.Context, r io.Reader, m *Matcher, cfg StreamConfig) (int, StreamStats, error) {
+	workers := cfg.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	size := cfg.ChunkSize
+	if size <= 0 {
+		size = DefaultChunkSize
+	}
+	stats := StreamStats{Workers: workers}
+	start := time.Now()
+
+	free := make(chan []byte, 2*workers)
+	for i := 0; i < cap(free); i++ {
+		free <- make([]byte, size)
+	}
+	chunks := make(chan []byte, workers)
+
+	var total, lines atomic.Int64
+	var wg sync.WaitGroup
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for chunk := range chunks {
+				sum, n := calibrateChunk(chunk, m)
+				total.Add(int64(sum))
+				lines.Add(int64(n))
+				free <- chunk[:cap(chunk)]
+			}
+		}()
+	}
+
+	err := splitChunks(ctx, r, free, chunks, &stats)
+	close(chunks)
+	wg.Wait()
+	stats.Lines = lines.Load()
+	stats.Elapsed = time.Since(start)
+	if err != nil {
+		return 0, stats, fmt.Errorf("reading calibration document: %w", err)
+	}
+	return int(total.Load()), stats, nil
+}
+
+// splitChunks fills buffers from free with r's bytes and sends them on
+// chunks, each cut after its last newline. The rest of the buffer is carried
+// over to the start of the next one. Buffers come back to free once summed.
+func splitChunks(ctx context.Context, r io.Reader, free chan []byte, chunks chan<- []byte, stats *StreamStats) error {
+	var carry []byte
+	for {
+		// select picks at random when a buffer is free too, so check first.
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		var buf []byte
+		select {
+		case buf = <-free:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if len(carry) >= len(buf) {
+			buf = make([]byte, 2*len(carry))
+		}
+		n := copy(buf, carry)
+		read, err := io.ReadFull(r, buf[n:])
+		stats.Bytes += int64(read)
+		data := buf[:n+read]
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			if len(data) > 0 {
+				stats.Chunks++
+				chunks <- data
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		cut := bytes.LastIndexByte(data, '\n') + 1
+		if cut == 0 {
+			// A line longer than the buffer: carry all of it into a larger
+			// one, which takes this one's place.
+			carry = append(carry[:0], data...)
+			free <- buf
+			continue
+		}
+		carry = append(carry[:0], data[cut:]...)
+		stats.Chunks++
+		chunks <- data[:cut]
+	}
+}
+
+// calibrateChunk sums the calibration values of the lines in chunk and
+// counts them. Like bufio.ScanLines it drops a trailing \r from each line.
+func calibrateChunk(chunk []byte, m *Matcher) (sum, lines int) {
+	for len(chunk) > 0 {
+		line := chunk
+		if i := bytes.IndexByte(chunk, '\n'); i >= 0 {
+			line, chunk = chunk[:i], chunk[i+1:]
+		} else {
+			chunk = nil
+		}
+		line = bytes.TrimSuffix(line, []byte{'\r'})
+		value, _ := m.Value(string(line))
+		sum += value
+		lines++
+	}
+	return sum, lines
+}
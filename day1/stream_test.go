@@ -0,0 +1,75 @@
+package day1
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"adventofcode23/aoc"
+)
+
+func TestCalibrateParallel(t *testing.T) {
+	var buf bytes.Buffer
+	if err := generate(&buf, 500, rand.New(rand.NewSource(1))); err != nil {
+		t.Fatal(err)
+	}
+	// A long line, a line without digits, CRLF and no final newline.
+	buf.WriteString(strings.Repeat("x", 300) + "7" + strings.Repeat("y", 300) + "\nnone\r\ntwo3\r\n4five")
+	in := buf.String()
+
+	want, err := Calibrate(context.Background(), strings.NewReader(in), spelled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, cfg := range []StreamConfig{{}, {Workers: 1, ChunkSize: 1}, {Workers: 3, ChunkSize: 64}, {Workers: 8, ChunkSize: 7}} {
+		// OneByteReader makes every read short, as a pipe's can be.
+		got, stats, err := CalibrateParallel(context.Background(), iotest.OneByteReader(strings.NewReader(in)), spelled, cfg)
+		if err != nil || got != want {
+			t.Errorf("CalibrateParallel(%+v) = %d, %v; want %d", cfg, got, err, want)
+		}
+		if stats.Bytes != int64(len(in)) || stats.Lines != 504 {
+			t.Errorf("CalibrateParallel(%+v) read %d bytes and %d lines, want %d and 504", cfg, stats.Bytes, stats.Lines, len(in))
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, _, err := CalibrateParallel(ctx, strings.NewReader(in), spelled, StreamConfig{}); err == nil {
+		t.Error("CalibrateParallel ignored a canceled context")
+	}
+}
+
+// TestPart1Variants checks that the default and parallel part 1 read the
+// same digits, non-ASCII ones such as '٣' being no digits at all.
+func TestPart1Variants(t *testing.T) {
+	in := "a٣b\n٣4x5\nno digits\n"
+	want, err := Part1(context.Background(), strings.NewReader(in))
+	if err != nil || want != 45 {
+		t.Fatalf("Part1 = %d, %v; want 45", want, err)
+	}
+	if got, err := Part1Parallel(context.Background(), strings.NewReader(in)); err != nil || got != want {
+		t.Errorf("Part1Parallel = %d, %v; want %d", got, err, want)
+	}
+}
+
+// TestCanceled checks that the line-by-line readers stop on a done context
+// like the stream does.
+func TestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	in := "1abc2\npqr3stu8vwx\n"
+	var canceled *aoc.CanceledError
+	if _, err := Part1(ctx, strings.NewReader(in)); !errors.As(err, &canceled) {
+		t.Errorf("Part1 = %v, want a canceled error", err)
+	}
+	if _, err := Calibrate(ctx, strings.NewReader(in), spelled); !errors.As(err, &canceled) {
+		t.Errorf("Calibrate = %v, want a canceled error", err)
+	}
+	if _, err := Explain(ctx, strings.NewReader(in), spelled, func(Line) error { return nil }); !errors.As(err, &canceled) {
+		t.Errorf("Explain = %v, want a canceled error", err)
+	}
+}
@@ -0,0 +1,89 @@
+package day1
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+// Vocabulary maps the words of a language to the digits they stand for.
+type Vocabulary map[string]int
+
+// Literal is the digits themselves.
+var Literal = Vocabulary{"0": 0, "1": 1, "2": 2, "3": 3, "4": 4, "5": 5, "6": 6, "7": 7, "8": 8, "9": 9}
+
+// Presets are the built-in vocabularies. Like the puzzle's, they spell one
+// to nine; Roman numerals have no zero anyway.
+var Presets = map[string]Vocabulary{
+	"english": {"one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6, "seven": 7, "eight": 8, "nine": 9},
+	"german":  {"eins": 1, "zwei": 2, "drei": 3, "vier": 4, "fünf": 5, "sechs": 6, "sieben": 7, "acht": 8, "neun": 9},
+	"french":  {"un": 1, "deux": 2, "trois": 3, "quatre": 4, "cinq": 5, "six": 6, "sept": 7, "huit": 8, "neuf": 9},
+	"chinese": {"一": 1, "二": 2, "三": 3, "四": 4, "五": 5, "六": 6, "七": 7, "八": 8, "九": 9},
+	"roman":   {"I": 1, "II": 2, "III": 3, "IV": 4, "V": 5, "VI": 6, "VII": 7, "VIII": 8, "IX": 9},
+}
+
+// PresetNames returns the names of the presets in order.
+func PresetNames() []string {
+	names := make([]string, 0, len(Presets))
+	for name := range Presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadVocabulary reads a vocabulary from a JSON object of words and digits,
+// such as {"eins": 1, "zwei": 2}.
+func LoadVocabulary(path string) (Vocabulary, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var v Vocabulary
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := v.check(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return v, nil
+}
+
+// check reports an empty word or a word that is not a single digit.
+func (v Vocabulary) check() error {
+	for word, digit := range v {
+		if word == "" {
+			return fmt.Errorf("empty word for %d", digit)
+		}
+		if digit < 0 || digit > 9 {
+			return fmt.Errorf("word %q stands for %d, which is not a digit", word, digit)
+		}
+	}
+	return nil
+}
+
+// Merge combines vocabularies. A word that two of them give different
+// digits is an error.
+func Merge(vocabs ...Vocabulary) (Vocabulary, error) {
+	merged := Vocabulary{}
+	var conflicts []string
+	for _, v := range vocabs {
+		if err := v.check(); err != nil {
+			return nil, err
+		}
+		for word, digit := range v {
+			if old, ok := merged[word]; ok && old != digit {
+				conflicts = append(conflicts, fmt.Sprintf("%q is both %d and %d", word, old, digit))
+				continue
+			}
+			merged[word] = digit
+		}
+	}
+	if len(conflicts) > 0 {
+		sort.Strings(conflicts)
+		return nil, fmt.Errorf("conflicting vocabularies: %s", strings.Join(conflicts, ", "))
+	}
+	return merged, nil
+}
@@ -0,0 +1,83 @@
+// Package day10 solves Day 10: Pipe Maze.
+package day10
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"adventofcode23/aoc"
+	"adventofcode23/grid"
+	"adventofcode23/input"
+)
+
+const title = "Pipe Maze"
+
+func init() {
+	aoc.RegisterParser(10, aoc.ParseOnly(readGrid))
+	aoc.RegisterGenerator(10, generate)
+	aoc.Register(aoc.New(10, 1, title, aoc.IntFunc(Part1)))
+}
+
+// Function to read the grid from r
+func readGrid(r io.Reader) (*grid.Grid, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range input.Spans(lines) {
+		if err := line.CheckChars("|-LJ7F.S"); err != nil {
+			return nil, err
+		}
+	}
+	return grid.FromLines(lines)
+}
+
+func canMove(from, to byte, dir int) bool {
+	switch from {
+	case '|':
+		return (dir == 0 || dir == 2) && (to == '|' || to == 'S' || to == '7' || to == 'F')
+	case '-':
+		return (dir == 1 || dir == 3) && (to == '-' || to == 'S' || to == 'L' || to == 'J')
+	case 'L':
+		return ((dir == 0 && (to == '|' || to == 'S' || to == '7' || to == 'F')) || (dir == 3 && (to == '-' || to == 'S' || to == 'L' || to == 'J')))
+	case 'J':
+		return ((dir == 0 && (to == '|' || to == 'S' || to == '7' || to == 'F')) || (dir == 1 && (to == '-' || to == 'S' || to == 'L' || to == 'J')))
+	case '7':
+		return ((dir == 2 && (to == '|' || to == 'S' || to == '7' || to == 'F')) || (dir == 1 && (to == '-' || to == 'S' || to == 'L' || to == 'J')))
+	case 'F':
+		return ((dir == 2 && (to == '|' || to == 'S' || to == '7' || to == 'F')) || (dir == 3 && (to == '-' || to == 'S' || to == 'L' || to == 'J')))
+	case 'S':
+		return to == '|' || to == '-' || to == 'L' || to == 'J' || to == '7' || to == 'F'
+	}
+	return false
+}
+
+// followLoop walks the pipes connected to start and returns the steps to each tile.
+// dir indexes grid.Dirs4, which is ordered N, E, S, W.
+func followLoop(tiles *grid.Grid, start grid.Point) *grid.Distances {
+	return tiles.BFS(start, func(from, to grid.Point, dir int) bool {
+		nextPipe := tiles.At(to)
+		return nextPipe != '.' && canMove(tiles.At(from), nextPipe, dir)
+	})
+}
+
+// Part1 finds how many steps along the loop the farthest point is from the start.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	tiles, err := readGrid(r)
+	if err != nil {
+		return 0, err
+	}
+
+	start, found := tiles.Find('S')
+	if !found {
+		return 0, errors.New("starting position 'S' not found in the grid")
+	}
+
+	distances := followLoop(tiles, start)
+
+	// Find the maximum distance in the loop
+	_, maxDistance := distances.Max()
+	return maxDistance, nil
+}
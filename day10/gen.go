@@ -0,0 +1,117 @@
+package day10
+
+import (
+	"bufio"
+	"io"
+	"math/rand"
+
+	"adventofcode23/grid"
+)
+
+// generate writes a size by size maze with one loop through S. The loop is
+// the outline of a shape with a ragged top and bottom edge, which never
+// crosses itself. Tiles off the loop hold stray pipes, except next to S,
+// so only the loop connects to the start.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	side := max(3, size)
+	tiles := grid.New(side, side, '.')
+	loop := outline(rng, side)
+	for i, p := range loop {
+		prev, next := loop[(i+len(loop)-1)%len(loop)], loop[(i+1)%len(loop)]
+		tiles.Set(p, pipeBetween(prev.Sub(p), next.Sub(p)))
+	}
+
+	onLoop := make(map[grid.Point]bool, len(loop))
+	for _, p := range loop {
+		onLoop[p] = true
+	}
+	start := loop[rng.Intn(len(loop))]
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			p := grid.Point{X: x, Y: y}
+			if !onLoop[p] && rng.Intn(3) == 0 && p.Manhattan(start) > 1 {
+				tiles.Set(p, "|-LJ7F"[rng.Intn(6)])
+			}
+		}
+	}
+	tiles.Set(start, 'S')
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(tiles.String())
+	return bw.Flush()
+}
+
+// outline returns the tiles around an x-monotone shape in the side by side
+// grid, in order. Between columns i and i+1 the shape's top edge is at
+// top[i] and its bottom edge at bottom[i]; where the edges step up or down
+// at a column the two steps must not meet.
+func outline(rng *rand.Rand, side int) []grid.Point {
+	x0 := rng.Intn(side/4 + 1)
+	x1 := side - 1 - rng.Intn(side/4+1)
+	if x1 == x0 {
+		x1 = x0 + 1
+	}
+	n := x1 - x0
+	top, bottom := make([]int, n), make([]int, n)
+	top[0] = rng.Intn(side - 1)
+	bottom[0] = top[0] + 1 + rng.Intn(side-1-top[0])
+	for i := 1; i < n; i++ {
+		top[i], bottom[i] = top[i-1], bottom[i-1]
+		if rng.Intn(2) == 0 {
+			top[i] = rng.Intn(bottom[i-1])
+		}
+		if rng.Intn(2) == 0 {
+			low := max(top[i-1], top[i]) + 1
+			bottom[i] = low + rng.Intn(side-low)
+		}
+	}
+
+	corners := []grid.Point{{X: x0, Y: top[0]}}
+	for i := 0; i < n; i++ {
+		corners = append(corners, grid.Point{X: x0 + i, Y: bottom[i]}, grid.Point{X: x0 + i + 1, Y: bottom[i]})
+	}
+	for i := n - 1; i >= 0; i-- {
+		corners = append(corners, grid.Point{X: x0 + i + 1, Y: top[i]}, grid.Point{X: x0 + i, Y: top[i]})
+	}
+
+	var loop []grid.Point
+	p := corners[0]
+	for _, c := range corners[1:] {
+		for p != c {
+			loop = append(loop, p)
+			p = p.Add(grid.Point{X: sign(c.X - p.X), Y: sign(c.Y - p.Y)})
+		}
+	}
+	return loop
+}
+
+// pipeBetween returns the pipe joining the tile to its neighbours at
+// offsets a and b.
+func pipeBetween(a, b grid.Point) byte {
+	for _, pipe := range []struct {
+		c    byte
+		a, b grid.Point
+	}{
+		{'|', grid.Point{Y: -1}, grid.Point{Y: 1}},
+		{'-', grid.Point{X: -1}, grid.Point{X: 1}},
+		{'L', grid.Point{Y: -1}, grid.Point{X: 1}},
+		{'J', grid.Point{Y: -1}, grid.Point{X: -1}},
+		{'7', grid.Point{Y: 1}, grid.Point{X: -1}},
+		{'F', grid.Point{Y: 1}, grid.Point{X: 1}},
+	} {
+		if (a == pipe.a && b == pipe.b) || (a == pipe.b && b == pipe.a) {
+			return pipe.c
+		}
+	}
+	panic("day10: loop tiles are not neighbours")
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
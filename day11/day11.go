@@ -0,0 +1,133 @@
+// Package day11 solves Day 11: Cosmic Expansion.
+package day11
+
+import (
+	"context"
+	"io"
+
+	"adventofcode23/aoc"
+	"adventofcode23/grid"
+	"adventofcode23/input"
+)
+
+const title = "Cosmic Expansion"
+
+func init() {
+	aoc.RegisterParser(11, aoc.ParseOnly(ReadUniverse))
+	aoc.RegisterGenerator(11, generate)
+	aoc.Register(aoc.New(11, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.NewVariant(11, 1, "factor", title, Part1Factor))
+	aoc.Register(aoc.New(11, 2, title, Part2))
+	aoc.Register(aoc.NewVariant(11, 2, "bfs", title, Part2BFS))
+	aoc.RegisterReference(11, 1, aoc.DefaultVariant)
+	aoc.RegisterReference(11, 2, "bfs")
+}
+
+// Expand the universe based on the given rules
+func expandUniverse(universe *grid.Grid) *grid.Grid {
+	rows, cols := universe.Height(), universe.Width()
+
+	// Step 1: Identify empty rows and columns
+	emptyRows := make([]bool, rows)
+	emptyCols := make([]bool, cols)
+	for _, p := range universe.FindAll('#') {
+		emptyRows[p.Y] = true
+		emptyCols[p.X] = true
+	}
+
+	// Calculate new size
+	newRows := 0
+	for _, empty := range emptyRows {
+		if !empty {
+			newRows += 2
+		} else {
+			newRows++
+		}
+	}
+	newCols := 0
+	for _, empty := range emptyCols {
+		if !empty {
+			newCols += 2
+		} else {
+			newCols++
+		}
+	}
+
+	// Step 2: Create new expanded universe
+	newUniverse := grid.New(newCols, newRows, '.')
+
+	// Step 3: Copy data to the new grid
+	newRow := 0
+	for r := 0; r < rows; r++ {
+		newCol := 0
+		for c, val := range universe.Row(r) {
+			if val != '.' {
+				newUniverse.Set(grid.Point{X: newCol, Y: newRow}, val)
+			}
+			if !emptyCols[c] {
+				newCol++
+			}
+			newCol++
+		}
+		if !emptyRows[r] {
+			copy(newUniverse.Row(newRow+1), newUniverse.Row(newRow))
+			newRow++
+		}
+		newRow++
+	}
+
+	return newUniverse
+}
+
+// Calculate the sum of the shortest path lengths between all pairs of galaxies
+func sumOfShortestPathLengths(ctx context.Context, universe *grid.Grid) (int, error) {
+	log := aoc.Logger(ctx)
+	expandedUniverse := expandUniverse(universe)
+	galaxies := expandedUniverse.FindAll('#')
+	log.Debug("expanded universe", "width", expandedUniverse.Width(), "height", expandedUniverse.Height(), "galaxies", len(galaxies))
+
+	// Calculate the sum of the shortest path lengths, searching once from
+	// each galaxy and reading off the distances to the galaxies after it
+	sum := 0
+	for i, src := range galaxies {
+		if err := aoc.Canceled(ctx, "galaxy %d of %d", i+1, len(galaxies)); err != nil {
+			return 0, err
+		}
+		distances := expandedUniverse.BFS(src, nil)
+		for j, dest := range galaxies[i+1:] {
+			sum += distances.At(dest)
+			log.Log(ctx, aoc.LevelTrace, "path", "from", i+1, "to", i+j+2, "length", distances.At(dest))
+		}
+	}
+
+	return sum, nil
+}
+
+// ReadUniverse reads the universe image from r, checking that it is a
+// rectangle of '.' and '#'.
+func ReadUniverse(r io.Reader) (*grid.Grid, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(lines) == 0 {
+		return nil, &input.ParseError{Line: 1, Want: "an image of the universe"}
+	}
+
+	for _, line := range input.Spans(lines) {
+		if err := line.CheckChars(".#"); err != nil {
+			return nil, err
+		}
+	}
+	return grid.FromLines(lines)
+}
+
+// Part1 sums the shortest paths between every pair of galaxies after the universe doubles its empty rows and columns.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	universe, err := ReadUniverse(r)
+	if err != nil {
+		return 0, err
+	}
+
+	return sumOfShortestPathLengths(ctx, universe)
+}
@@ -0,0 +1,195 @@
+package day11
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sort"
+
+	"adventofcode23/aoc"
+	"adventofcode23/checked"
+	"adventofcode23/grid"
+)
+
+// Identify which rows and columns are empty in the universe and map galaxies
+func analyzeUniverse(ctx context.Context, universe *grid.Grid) (map[int]bool, map[int]bool, map[int]grid.Point, int) {
+	emptyRows, emptyCols := identifyEmptyRowsAndCols(universe)
+	galaxyMap := make(map[int]grid.Point)
+	galaxyCount := 0
+
+	for _, p := range universe.FindAll('#') {
+		galaxyMap[galaxyCount] = p
+		galaxyCount++
+	}
+	log := aoc.Logger(ctx)
+	log.Debug("universe", "empty_rows", trueKeys(emptyRows), "empty_cols", trueKeys(emptyCols), "galaxies", galaxyCount)
+	if log.Enabled(ctx, aoc.LevelTrace) {
+		for i := 0; i < galaxyCount; i++ {
+			log.Log(ctx, aoc.LevelTrace, "galaxy", "n", i, "x", galaxyMap[i].X, "y", galaxyMap[i].Y)
+		}
+	}
+
+	return emptyRows, emptyCols, galaxyMap, galaxyCount
+}
+
+// trueKeys returns the keys set to true in m, in order.
+func trueKeys(m map[int]bool) []int {
+	var keys []int
+	for k, v := range m {
+		if v {
+			keys = append(keys, k)
+		}
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// pathLength is a path through the expanded universe: its steps in the
+// image, and how many of them enter an empty row or column and so count
+// expansionFactor times.
+type pathLength struct {
+	steps, crossings int
+}
+
+// expandedPathLengths is a breadth-first search from src that records, for
+// each cell, how many empty rows and columns the path to it crosses.
+func expandedPathLengths(universe *grid.Grid, src grid.Point, emptyRows, emptyCols map[int]bool) map[grid.Point]pathLength {
+	distances := map[grid.Point]pathLength{src: {}}
+	queue := []grid.Point{src}
+
+	for len(queue) > 0 {
+		point := queue[0]
+		queue = queue[1:]
+		distance := distances[point]
+
+		for _, dir := range grid.Dirs4 {
+			nextPoint := point.Add(dir)
+			if _, seen := distances[nextPoint]; seen || !universe.In(nextPoint) {
+				continue
+			}
+			next := pathLength{distance.steps + 1, distance.crossings}
+			if (dir.Y != 0 && emptyRows[nextPoint.Y]) || (dir.X != 0 && emptyCols[nextPoint.X]) {
+				next.crossings++
+			}
+			distances[nextPoint] = next
+			queue = append(queue, nextPoint)
+		}
+	}
+
+	return distances
+}
+
+// sumOfExpandedPathLengths sums the path lengths between every pair of
+// galaxies, moving to big integers if the sum overflows an int.
+func sumOfExpandedPathLengths(ctx context.Context, universe *grid.Grid, expansionFactor int) (aoc.Answer, error) {
+	emptyRows, emptyCols, galaxyMap, galaxyCount := analyzeUniverse(ctx, universe)
+	var sum checked.Sum
+
+	for g1 := 0; g1 < galaxyCount; g1++ {
+		if err := aoc.Canceled(ctx, "galaxy %d of %d", g1+1, galaxyCount); err != nil {
+			return aoc.Answer{}, err
+		}
+		distances := expandedPathLengths(universe, galaxyMap[g1], emptyRows, emptyCols)
+		for g2 := g1 + 1; g2 < galaxyCount; g2++ {
+			d := distances[galaxyMap[g2]]
+			sum.Add(d.steps)
+			sum.AddProduct(d.crossings, expansionFactor-1)
+		}
+	}
+
+	return aoc.Big(sum.Big()), nil
+}
+
+// sumOfFactorDistances sums the same path lengths as
+// sumOfExpandedPathLengths without searching: with nothing in the way, a
+// shortest path is as long as the distance between its ends on each axis
+// and crosses every empty row and column in between.
+func sumOfFactorDistances(ctx context.Context, universe *grid.Grid, expansionFactor int) (aoc.Answer, error) {
+	emptyRows, emptyCols, galaxyMap, galaxyCount := analyzeUniverse(ctx, universe)
+	xs, ys := make([]int, galaxyCount), make([]int, galaxyCount)
+	for i := 0; i < galaxyCount; i++ {
+		xs[i], ys[i] = galaxyMap[i].X, galaxyMap[i].Y
+	}
+
+	var sum checked.Sum
+	for _, axis := range []struct {
+		coords []int
+		empty  map[int]bool
+	}{{xs, emptyCols}, {ys, emptyRows}} {
+		steps, crossings := axisDistances(axis.coords, axis.empty)
+		sum.Add(steps)
+		sum.AddProduct(crossings, expansionFactor-1)
+	}
+	return aoc.Big(sum.Big()), nil
+}
+
+// axisDistances sums, over every pair of coordinates, the distance between
+// them and the number of empty lines between them.
+func axisDistances(coords []int, empty map[int]bool) (steps, crossings int) {
+	sort.Ints(coords)
+	var coordSum, emptySum, emptyBefore, line int
+	for i, c := range coords {
+		for ; line < c; line++ {
+			if empty[line] {
+				emptyBefore++
+			}
+		}
+		steps += i*c - coordSum
+		crossings += i*emptyBefore - emptySum
+		coordSum += c
+		emptySum += emptyBefore
+	}
+	return steps, crossings
+}
+
+// Identify which rows and columns are empty in the universe
+func identifyEmptyRowsAndCols(universe *grid.Grid) (map[int]bool, map[int]bool) {
+	emptyRows := make(map[int]bool)
+	emptyCols := make(map[int]bool)
+	for y := 0; y < universe.Height(); y++ {
+		if bytes.IndexByte(universe.Row(y), '#') < 0 {
+			emptyRows[y] = true
+		}
+	}
+
+	for x := 0; x < universe.Width(); x++ {
+		emptyCols[x] = bytes.IndexByte(universe.Col(x), '#') < 0
+	}
+
+	return emptyRows, emptyCols
+}
+
+// Part1Factor answers Part1 with the expansion-factor arithmetic of Part2
+// instead of building the expanded universe.
+func Part1Factor(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+	universe, err := ReadUniverse(r)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+
+	return sumOfExpandedPathLengths(ctx, universe, 2)
+}
+
+// partTwoExpansion is how many rows or columns each empty one becomes in part 2.
+const partTwoExpansion = 1000000
+
+// Part2 is Part1 with every empty row and column replaced by a million of them.
+func Part2(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+	universe, err := ReadUniverse(r)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+
+	return sumOfFactorDistances(ctx, universe, partTwoExpansion)
+}
+
+// Part2BFS answers Part2 by searching from each galaxy and counting the
+// empty rows and columns each path crosses.
+func Part2BFS(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+	universe, err := ReadUniverse(r)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+
+	return sumOfExpandedPathLengths(ctx, universe, partTwoExpansion)
+}
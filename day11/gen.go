@@ -0,0 +1,31 @@
+package day11
+
+import (
+	"bufio"
+	"io"
+	"math/rand"
+
+	"adventofcode23/grid"
+)
+
+// generate writes a size by size image in which about one tile in fifty is
+// a galaxy, as in the real input, leaving some rows and columns empty to
+// expand. There are always at least two galaxies to pair up.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	side := max(2, size)
+	universe := grid.New(side, side, '.')
+	for y := 0; y < side; y++ {
+		for x := 0; x < side; x++ {
+			if rng.Intn(50) == 0 {
+				universe.Set(grid.Point{X: x, Y: y}, '#')
+			}
+		}
+	}
+	for len(universe.FindAll('#')) < 2 {
+		universe.Set(grid.Point{X: rng.Intn(side), Y: rng.Intn(side)}, '#')
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString(universe.String())
+	return bw.Flush()
+}
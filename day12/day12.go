@@ -0,0 +1,149 @@
+// Package day12 solves Day 12: Hot Springs.
+package day12
+
+import (
+	"context"
+	_ "embed"
+	"io"
+	"strings"
+
+	"adventofcode23/aoc"
+	"adventofcode23/checked"
+	"adventofcode23/input"
+)
+
+//go:embed example.txt
+var example string
+
+const title = "Hot Springs"
+
+func init() {
+	input.RegisterExample(12, example)
+	aoc.RegisterParser(12, aoc.ParseOnly(readRows))
+	aoc.RegisterGenerator(12, generate)
+	aoc.Register(aoc.New(12, 1, title, aoc.IntFunc(Part1)))
+}
+
+// Parses the input into springs' conditions and group sizes.
+func parseInput(row input.Span) (string, []int, error) {
+	conditions, groupsStr, found := row.Cut(" ")
+	if !found {
+		return "", nil, row.Errorf(`"<conditions> <group sizes>"`)
+	}
+	if err := conditions.CheckChars(".#?"); err != nil {
+		return "", nil, err
+	}
+
+	var groups []int
+	for _, g := range groupsStr.Split(",") {
+		size, err := g.Int()
+		if err != nil {
+			return "", nil, err
+		}
+		if size < 1 {
+			return "", nil, g.Errorf("a group size of at least 1")
+		}
+		groups = append(groups, size)
+	}
+
+	return conditions.Text, groups, nil
+}
+
+// readRows reads the condition records, checking the format of each row.
+func readRows(r io.Reader) ([]string, error) {
+	rows, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range input.Spans(rows) {
+		if _, _, err := parseInput(row); err != nil {
+			return nil, err
+		}
+	}
+	return rows, nil
+}
+
+func countArrangementsForRow(ctx context.Context, conditions string, groups []int) int {
+	log := aoc.Logger(ctx)
+	// A row can hold at most as many damaged springs as it is long; this
+	// also bounds the table below, whose size the input would otherwise set.
+	totalSprings := 0
+	for _, g := range groups {
+		var ok bool
+		if totalSprings, ok = checked.Add(totalSprings, g); !ok || totalSprings > len(conditions) {
+			return 0
+		}
+	}
+
+	// 已存在的温泉数量
+	existingSprings := strings.Count(conditions, "#")
+	unknowns := strings.Count(conditions, "?")
+	extraSprings := totalSprings - existingSprings
+	log.Debug("row", "conditions", conditions, "existing", existingSprings, "unknowns", unknowns, "extra", extraSprings)
+	if extraSprings < 0 || extraSprings > unknowns {
+		return 0 // more damaged springs than the groups hold, or than fit
+	}
+
+	// 初始化 dp 数组
+	dp := make([][][]int, unknowns+1)
+	for i := range dp {
+		dp[i] = make([][]int, extraSprings+1)
+		for j := range dp[i] {
+			dp[i][j] = make([]int, len(groups)+1) // 根据温泉组的数量调整第三维的大小
+		}
+	}
+
+	dp[0][0][0] = 1 // 从第一个温泉组开始
+
+	// 动态规划状态转移
+	for i := 1; i <= unknowns; i++ {
+		for j := 0; j <= extraSprings; j++ {
+			for k := 0; k < len(groups); k++ {
+				// 如果当前位置放置温泉
+				if j+1 <= extraSprings && (k == 0 || dp[i-1][j][k-1] > 0) {
+					dp[i][j+1][k+1] += dp[i-1][j][k] // 注意更新状态为 k+1
+				}
+				// 如果当前位置放置空地
+				if k > 0 {
+					dp[i][j][k-1] += dp[i-1][j][k] // 考虑转移到前一个温泉组
+				}
+			}
+		}
+	}
+
+	// 输出用于调试的结果
+	if log.Enabled(ctx, aoc.LevelTrace) {
+		for i := 0; i <= unknowns; i++ {
+			for j := 0; j <= extraSprings; j++ {
+				log.Log(ctx, aoc.LevelTrace, "dp", "conditions", conditions, "i", i, "j", j, "by_group", dp[i][j])
+			}
+		}
+	}
+	return dp[unknowns][extraSprings][0]
+}
+
+// Sums up the arrangements from all rows.
+func sumArrangements(ctx context.Context, rows []string) (int, error) {
+	total := 0
+	for _, row := range input.Spans(rows) {
+		conditions, groups, err := parseInput(row)
+		if err != nil {
+			return 0, err
+		}
+
+		aoc.Logger(ctx).Log(ctx, aoc.LevelTrace, "parsed row", "conditions", conditions, "groups", groups)
+
+		total += countArrangementsForRow(ctx, conditions, groups)
+	}
+	return total, nil
+}
+
+// Part1 sums the possible arrangements of every row of the condition record.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	rows, err := input.Lines(r)
+	if err != nil {
+		return 0, err
+	}
+
+	return sumArrangements(ctx, rows)
+}
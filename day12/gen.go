@@ -0,0 +1,39 @@
+package day12
+
+import (
+	"bufio"
+	"io"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// generate writes size rows of up to 20 springs. Each row starts as a real
+// arrangement with at least one damaged spring; its groups are read off it
+// and then about half of the springs are hidden behind '?', so every row
+// has at least one arrangement.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	bw := bufio.NewWriter(w)
+	for i := 0; i < size; i++ {
+		springs := make([]byte, 1+rng.Intn(20))
+		for j := range springs {
+			springs[j] = ".#"[rng.Intn(2)]
+		}
+		springs[rng.Intn(len(springs))] = '#'
+
+		var groups []string
+		for _, g := range strings.FieldsFunc(string(springs), func(r rune) bool { return r == '.' }) {
+			groups = append(groups, strconv.Itoa(len(g)))
+		}
+		for j := range springs {
+			if rng.Intn(2) == 0 {
+				springs[j] = '?'
+			}
+		}
+		bw.Write(springs)
+		bw.WriteByte(' ')
+		bw.WriteString(strings.Join(groups, ","))
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,111 @@
+// Package day13 solves Day 13: Point of Incidence.
+package day13
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"io"
+
+	"adventofcode23/aoc"
+	"adventofcode23/grid"
+	"adventofcode23/input"
+)
+
+//go:embed example.txt
+var example string
+
+const title = "Point of Incidence"
+
+func init() {
+	input.RegisterExample(13, example)
+	aoc.RegisterParser(13, aoc.ParseOnly(readPatterns))
+	aoc.RegisterGenerator(13, generate)
+	aoc.Register(aoc.New(13, 1, title, aoc.IntFunc(Part1)))
+}
+
+// readPatterns reads the blank-line separated patterns of '#' (rock) and '.' (ash).
+func readPatterns(r io.Reader) ([]*grid.Grid, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var patterns []*grid.Grid
+	var rows []string
+	flush := func() error {
+		if len(rows) > 0 {
+			pattern, err := grid.FromLines(rows)
+			if err != nil {
+				return err
+			}
+			patterns = append(patterns, pattern)
+			rows = nil
+		}
+		return nil
+	}
+	for _, line := range input.Spans(lines) {
+		if line.Text == "" {
+			if err := flush(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err := line.CheckChars("#."); err != nil {
+			return nil, err
+		}
+		if len(rows) > 0 && len(line.Text) != len(rows[0]) {
+			return nil, line.Errorf("a row of %d characters like the rest of the pattern", len(rows[0]))
+		}
+		rows = append(rows, line.Text)
+	}
+	if err := flush(); err != nil {
+		return nil, err
+	}
+	return patterns, nil
+}
+
+// Part1 adds up the columns left of each vertical reflection plus 100 times the rows
+// above each horizontal reflection.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	patterns, err := readPatterns(r)
+	if err != nil {
+		return 0, err
+	}
+
+	summary := 0
+	for _, pattern := range patterns {
+		// Check for the center of horizontal and vertical reflection
+		if center, found := findHorizontalReflectionCenter(pattern); found {
+			summary += 100 * (center + 1) // Lines are 1-indexed
+		}
+		if center, found := findVerticalReflectionCenter(pattern); found {
+			summary += center + 1 // Columns are 1-indexed
+		}
+	}
+	return summary, nil
+}
+
+// findHorizontalReflectionCenter returns the index of the last row above
+// the first horizontal line the pattern reflects across. Each line between
+// two rows is tried in turn, comparing the rows on either side of it outward
+// until one side runs out.
+func findHorizontalReflectionCenter(pattern *grid.Grid) (int, bool) {
+	numRows := pattern.Height()
+	for line := 1; line < numRows; line++ {
+		mirrored := true
+		for d := 0; mirrored && line-1-d >= 0 && line+d < numRows; d++ {
+			mirrored = bytes.Equal(pattern.Row(line-1-d), pattern.Row(line+d))
+		}
+		if mirrored {
+			return line - 1, true
+		}
+	}
+	return -1, false
+}
+
+// findVerticalReflectionCenter looks for a horizontal reflection in the
+// transposed pattern, whose rows are the original columns.
+func findVerticalReflectionCenter(pattern *grid.Grid) (int, bool) {
+	return findHorizontalReflectionCenter(pattern.Transpose())
+}
@@ -0,0 +1,72 @@
+package day13
+
+import (
+	"bufio"
+	"io"
+	"math/rand"
+
+	"adventofcode23/grid"
+)
+
+// generate writes size patterns of 5 to 17 rows and columns. Each pattern
+// is built around one mirror line, horizontal or vertical, and redrawn
+// until that is the only line it reflects across.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	bw := bufio.NewWriter(w)
+	for i := 0; i < size; i++ {
+		if i > 0 {
+			bw.WriteByte('\n')
+		}
+		bw.WriteString(mirroredPattern(rng).String())
+	}
+	return bw.Flush()
+}
+
+// mirroredPattern returns a random pattern with exactly one reflection.
+func mirroredPattern(rng *rand.Rand) *grid.Grid {
+	for {
+		w, h := 5+rng.Intn(13), 5+rng.Intn(13)
+		pattern := grid.New(w, h, '.')
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				if rng.Intn(2) == 0 {
+					pattern.Set(grid.Point{X: x, Y: y}, '#')
+				}
+			}
+		}
+
+		// Mirror the rows on one side of a line between rows onto the
+		// other side; a vertical line is the same on the transpose.
+		vertical := rng.Intn(2) == 0
+		if vertical {
+			pattern = pattern.Transpose()
+		}
+		line := 1 + rng.Intn(pattern.Height()-1)
+		for d := 0; line-1-d >= 0 && line+d < pattern.Height(); d++ {
+			copy(pattern.Row(line+d), pattern.Row(line-1-d))
+		}
+		if vertical {
+			pattern = pattern.Transpose()
+		}
+
+		if len(reflections(pattern))+len(reflections(pattern.Transpose())) == 1 {
+			return pattern
+		}
+	}
+}
+
+// reflections returns the number of rows above each horizontal line the
+// pattern reflects across.
+func reflections(pattern *grid.Grid) []int {
+	var lines []int
+	for line := 1; line < pattern.Height(); line++ {
+		mirrored := true
+		for d := 0; mirrored && line-1-d >= 0 && line+d < pattern.Height(); d++ {
+			mirrored = string(pattern.Row(line-1-d)) == string(pattern.Row(line+d))
+		}
+		if mirrored {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
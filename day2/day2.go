@@ -0,0 +1,36 @@
+// Package day2 solves Day 2: Cube Conundrum.
+package day2
+
+import (
+	"context"
+	"io"
+
+	"adventofcode23/aoc"
+)
+
+const title = "Cube Conundrum"
+
+func init() {
+	aoc.RegisterParser(2, aoc.ParseOnly(ReadGames))
+	aoc.RegisterGenerator(2, generate)
+	aoc.Register(aoc.New(2, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(2, 2, title, aoc.IntFunc(Part2)))
+}
+
+// PuzzleBag is the bag of part 1.
+var PuzzleBag = Bag{"red": 12, "green": 13, "blue": 14}
+
+// Part1 sums the IDs of the games that are possible with 12 red, 13 green and 14 blue cubes.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	games, err := ReadGames(r)
+	if err != nil {
+		return 0, err
+	}
+	var sumOfIDs int
+	for _, g := range games {
+		if g.Possible(PuzzleBag) {
+			sumOfIDs += g.ID
+		}
+	}
+	return sumOfIDs, nil
+}
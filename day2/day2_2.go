@@ -0,0 +1,19 @@
+package day2
+
+import (
+	"context"
+	"io"
+)
+
+// Part2 sums the power of the minimum set of cubes that makes each game possible.
+func Part2(ctx context.Context, r io.Reader) (int, error) {
+	games, err := ReadGames(r)
+	if err != nil {
+		return 0, err
+	}
+	var totalPower int
+	for _, g := range games {
+		totalPower += g.Minimum().Power(Colors)
+	}
+	return totalPower, nil
+}
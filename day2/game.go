@@ -0,0 +1,188 @@
+package day2
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"adventofcode23/input"
+)
+
+// Color is the color of a cube, such as "red".
+type Color string
+
+// Colors are the colors of the puzzle's cubes.
+var Colors = []Color{"red", "green", "blue"}
+
+// Game is one record: the cubes drawn from the bag in each handful.
+type Game struct {
+	ID    int
+	Draws []map[Color]int
+}
+
+// ReadGames reads the game records, one "Game <id>: <draws>" per line with
+// the draws separated by "; " and the cubes of a draw by ", ".
+func ReadGames(r io.Reader) ([]Game, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	games := make([]Game, 0, len(lines))
+	for _, line := range input.Spans(lines) {
+		g, err := parseGame(line)
+		if err != nil {
+			return nil, err
+		}
+		games = append(games, g)
+	}
+	return games, nil
+}
+
+func parseGame(line input.Span) (Game, error) {
+	header, draws, found := line.Cut(": ")
+	if !found {
+		return Game{}, line.Errorf(`"Game <id>: <cube sets>"`)
+	}
+	idStr, found := header.CutPrefix("Game ")
+	if !found {
+		return Game{}, header.Errorf(`"Game <id>"`)
+	}
+	id, err := idStr.Int()
+	if err != nil {
+		return Game{}, err
+	}
+
+	g := Game{ID: id}
+	for _, draw := range draws.Split("; ") {
+		cubes := map[Color]int{}
+		for _, cube := range draw.Split(", ") {
+			count, color, err := parseCube(cube)
+			if err != nil {
+				return Game{}, err
+			}
+			if _, dup := cubes[color]; dup {
+				return Game{}, cube.Errorf("a color not already in the draw")
+			}
+			cubes[color] = count
+		}
+		g.Draws = append(g.Draws, cubes)
+	}
+	return g, nil
+}
+
+// parseCube parses one "<count> <color>" entry of a draw.
+func parseCube(cube input.Span) (int, Color, error) {
+	countStr, color, found := cube.Cut(" ")
+	if !found {
+		return 0, "", cube.Errorf(`"<count> <color>"`)
+	}
+	if err := countStr.CheckChars("0123456789"); err != nil || countStr.Text == "" {
+		return 0, "", countStr.Errorf("a count of cubes")
+	}
+	count, err := countStr.Int()
+	if err != nil {
+		return 0, "", err
+	}
+	if err := color.CheckChars("abcdefghijklmnopqrstuvwxyz"); err != nil || color.Text == "" {
+		return 0, "", color.Errorf("a color name")
+	}
+	return count, Color(color.Text), nil
+}
+
+// Bag is how many cubes of each color a bag holds. A color it does not
+// mention is one it has none of.
+type Bag map[Color]int
+
+// ParseBag parses a bag written as "red=12,green=13,blue=14".
+func ParseBag(s string) (Bag, error) {
+	b := Bag{}
+	for _, entry := range strings.Split(s, ",") {
+		color, countStr, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found || color == "" {
+			return nil, fmt.Errorf("bag entry %q: want <color>=<count>", entry)
+		}
+		count, err := strconv.Atoi(countStr)
+		if err != nil || count < 0 {
+			return nil, fmt.Errorf("bag entry %q: invalid count", entry)
+		}
+		b[Color(color)] = count
+	}
+	return b, nil
+}
+
+// Colors returns the colors of b in order.
+func (b Bag) Colors() []Color {
+	colors := make([]Color, 0, len(b))
+	for c := range b {
+		colors = append(colors, c)
+	}
+	sort.Slice(colors, func(i, j int) bool { return colors[i] < colors[j] })
+	return colors
+}
+
+func (b Bag) String() string {
+	var parts []string
+	for _, c := range b.Colors() {
+		parts = append(parts, fmt.Sprintf("%s=%d", c, b[c]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// Power multiplies the counts of the given colors, so a color the bag does
+// not hold makes it 0.
+func (b Bag) Power(colors []Color) int {
+	power := 1
+	for _, c := range colors {
+		power *= b[c]
+	}
+	return power
+}
+
+// Minimum returns the smallest bag the game could have been played with:
+// for each color, the most cubes of it seen in one draw.
+func (g Game) Minimum() Bag {
+	bag := Bag{}
+	for _, draw := range g.Draws {
+		for c, n := range draw {
+			if n > bag[c] {
+				bag[c] = n
+			}
+		}
+	}
+	return bag
+}
+
+// Violation is a draw that shows more cubes of a color than the bag holds.
+type Violation struct {
+	Draw         int // counted from 1
+	Color        Color
+	Count, Limit int
+}
+
+// Violations returns every draw and color of g that bag b could not have
+// produced, in order.
+func (g Game) Violations(b Bag) []Violation {
+	var violations []Violation
+	for i, draw := range g.Draws {
+		for _, c := range Bag(draw).Colors() {
+			if n := draw[c]; n > b[c] {
+				violations = append(violations, Violation{Draw: i + 1, Color: c, Count: n, Limit: b[c]})
+			}
+		}
+	}
+	return violations
+}
+
+// Possible reports whether g could have been played with bag b.
+func (g Game) Possible(b Bag) bool {
+	for _, draw := range g.Draws {
+		for c, n := range draw {
+			if n > b[c] {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,61 @@
+package day2
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"adventofcode23/input"
+)
+
+const example = `Game 1: 3 blue, 4 red; 1 red, 2 green, 6 blue; 2 green
+Game 3: 8 green, 6 blue, 20 red; 5 blue, 4 red, 13 green; 5 green, 1 red
+`
+
+func TestGames(t *testing.T) {
+	games, err := ReadGames(strings.NewReader(example))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(games) != 2 || games[1].ID != 3 || len(games[1].Draws) != 3 {
+		t.Fatalf("ReadGames = %+v", games)
+	}
+
+	if !games[0].Possible(PuzzleBag) || games[1].Possible(PuzzleBag) {
+		t.Error("Possible disagrees with the puzzle's bag")
+	}
+	want := []Violation{{Draw: 1, Color: "red", Count: 20, Limit: 12}}
+	if got := games[1].Violations(PuzzleBag); !reflect.DeepEqual(got, want) {
+		t.Errorf("Violations = %+v, want %+v", got, want)
+	}
+	if got := games[0].Minimum(); got.String() != "blue=6,green=2,red=4" || got.Power(Colors) != 48 {
+		t.Errorf("Minimum = %v with power %d, want blue=6,green=2,red=4 with 48", got, got.Power(Colors))
+	}
+
+	bag, err := ParseBag("red=20, purple=5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The bag has no green or blue, so every draw showing them is ruled out.
+	if got := games[1].Violations(bag); len(got) != 5 || got[4] != (Violation{Draw: 3, Color: "green", Count: 5}) {
+		t.Errorf("Violations = %+v, want the 5 green and blue draws", got)
+	}
+	if games[0].Minimum().Power(bag.Colors()) != 0 {
+		t.Error("Power counted a color the game never shows")
+	}
+	if _, err := ParseBag("red=-1"); err == nil {
+		t.Error("ParseBag accepted a negative count")
+	}
+	for _, bad := range []string{
+		"Game 1: 1 red, 2 red",   // a color twice in one draw
+		"Game 1: 3 blue; -3 red", // a negative count
+		"Game 1: +3 red",         // a sign
+	} {
+		_, err := ReadGames(strings.NewReader(bad + "\n"))
+		var pe *input.ParseError
+		if !errors.As(err, &pe) {
+			t.Errorf("ReadGames(%q) = %v, want a ParseError", bad, err)
+		}
+	}
+}
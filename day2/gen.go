@@ -0,0 +1,32 @@
+package day2
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+// generate writes size games of one to six sets each. Counts go up to 20,
+// so some games fit the bag of part 1 and some do not.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	colors := []string{"red", "green", "blue"}
+	bw := bufio.NewWriter(w)
+	for id := 1; id <= size; id++ {
+		fmt.Fprintf(bw, "Game %d: ", id)
+		for set := 1 + rng.Intn(6); set > 0; set-- {
+			shown := rng.Perm(len(colors))[:1+rng.Intn(len(colors))]
+			for i, c := range shown {
+				if i > 0 {
+					bw.WriteString(", ")
+				}
+				fmt.Fprintf(bw, "%d %s", 1+rng.Intn(20), colors[c])
+			}
+			if set > 1 {
+				bw.WriteString("; ")
+			}
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,71 @@
+// Package day3 solves Day 3: Gear Ratios.
+package day3
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"adventofcode23/aoc"
+	"adventofcode23/grid"
+)
+
+const title = "Gear Ratios"
+
+func init() {
+	aoc.RegisterParser(3, aoc.ParseOnly(grid.Read))
+	aoc.RegisterGenerator(3, generate)
+	aoc.Register(aoc.New(3, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(3, 2, title, aoc.IntFunc(Part2)))
+}
+
+// Part1 sums every number in the engine schematic that is adjacent to a symbol.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	schematic, err := grid.Read(r)
+	if err != nil {
+		return 0, err
+	}
+	sum := 0
+	for y := 0; y < schematic.Height(); y++ {
+		line := schematic.Row(y)
+		for x := 0; x < len(line); x++ {
+			if isDigit(line[x]) {
+				// Find the end of the number sequence
+				end := x
+				for end < len(line) && isDigit(line[end]) {
+					end++
+				}
+
+				// Check if the number sequence is adjacent to a symbol
+				if isNumberAdjacentToSymbol(x, end-1, y, schematic) {
+					value, _ := strconv.Atoi(string(line[x:end]))
+					sum += value
+					x = end - 1 // Skip the rest of the number sequence
+				}
+			}
+		}
+	}
+	return sum, nil
+}
+
+func isDigit(ch byte) bool {
+	return ch >= '0' && ch <= '9'
+}
+
+func isNumberAdjacentToSymbol(startX, endX, y int, schematic *grid.Grid) bool {
+	for x := startX; x <= endX; x++ {
+		if isAdjacentToSymbol(grid.Point{X: x, Y: y}, schematic) {
+			return true
+		}
+	}
+	return false
+}
+
+func isAdjacentToSymbol(p grid.Point, schematic *grid.Grid) bool {
+	for _, n := range p.Neighbors8() {
+		if adjCh, ok := schematic.Get(n); ok && !isDigit(adjCh) && adjCh != '.' {
+			return true
+		}
+	}
+	return false
+}
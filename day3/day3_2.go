@@ -0,0 +1,63 @@
+package day3
+
+import (
+	"context"
+	"io"
+	"strconv"
+
+	"adventofcode23/grid"
+)
+
+// Part2 sums the gear ratios of every '*' adjacent to exactly two part numbers.
+func Part2(ctx context.Context, r io.Reader) (int, error) {
+	schematic, err := grid.Read(r)
+	if err != nil {
+		return 0, err
+	}
+
+	gearSum := 0
+	for _, gear := range schematic.FindAll('*') {
+		partNumbers := findAdjacentPartNumbers(gear, schematic)
+		if len(partNumbers) == 2 {
+			gearRatio := partNumbers[0] * partNumbers[1]
+			gearSum += gearRatio
+		}
+	}
+	return gearSum, nil
+}
+
+func findAdjacentPartNumbers(p grid.Point, schematic *grid.Grid) []int {
+	seen := make(map[grid.Point]bool) // 用来记录已经处理过的数字序列, 以序列的起点为键
+	var partNumbers []int
+
+	for _, n := range p.Neighbors8() {
+		if !schematic.In(n) {
+			continue
+		}
+		start, end := findNumberSequence(n, schematic)
+		if start != -1 {
+			seqKey := grid.Point{X: start, Y: n.Y}
+			if !seen[seqKey] {
+				seen[seqKey] = true
+				value, _ := strconv.Atoi(string(schematic.Row(n.Y)[start:end]))
+				partNumbers = append(partNumbers, value)
+			}
+		}
+	}
+	return partNumbers
+}
+
+func findNumberSequence(p grid.Point, schematic *grid.Grid) (int, int) {
+	line := schematic.Row(p.Y)
+	if !isDigit(line[p.X]) {
+		return -1, -1
+	}
+	start, end := p.X, p.X
+	for start > 0 && isDigit(line[start-1]) {
+		start--
+	}
+	for end < len(line)-1 && isDigit(line[end+1]) {
+		end++
+	}
+	return start, end + 1
+}
@@ -0,0 +1,46 @@
+package day3
+
+import (
+	"bufio"
+	"io"
+	"math/rand"
+	"strconv"
+)
+
+// generate writes a schematic of size rows of 140 columns, like the real
+// input. Numbers of one to three digits are scattered with gaps between
+// them, and symbols, including gears with two neighbouring numbers, are
+// dropped beside some of them.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	const width = 140
+	const symbols = "*#+$/@=%&-"
+	rows := make([][]byte, size)
+	for y := range rows {
+		rows[y] = make([]byte, width)
+		for x := range rows[y] {
+			rows[y][x] = '.'
+		}
+	}
+
+	for _, row := range rows {
+		for x := rng.Intn(4); x < width-3; x += 2 + rng.Intn(6) {
+			n := strconv.Itoa(1 + rng.Intn(999))
+			copy(row[x:], n)
+			x += len(n)
+		}
+		// Put a symbol in some of the gaps, often enough that most numbers
+		// touch one and some '*' touch two.
+		for x := range row {
+			if row[x] == '.' && rng.Intn(5) == 0 {
+				row[x] = symbols[rng.Intn(len(symbols))]
+			}
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	for _, row := range rows {
+		bw.Write(row)
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,113 @@
+// Package day4 solves Day 4: Scratchcards.
+package day4
+
+import (
+	"context"
+	"io"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+const title = "Scratchcards"
+
+func init() {
+	aoc.RegisterParser(4, aoc.ParseOnly(readCards))
+	aoc.RegisterGenerator(4, generate)
+	aoc.Register(aoc.New(4, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(4, 2, title, Part2))
+}
+
+type Card struct {
+	WinningNumbers []int
+	YourNumbers    []int
+}
+
+func readCards(r io.Reader) ([]Card, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var cards []Card
+	for _, line := range input.Spans(lines) {
+		card, err := parseCard(line)
+		if err != nil {
+			return nil, err
+		}
+		cards = append(cards, card)
+	}
+	return cards, nil
+}
+
+func parseCard(line input.Span) (Card, error) {
+	numbers, yours, found := line.Cut("|")
+	if !found {
+		return Card{}, line.Errorf(`"Card <id>: <winning numbers> | <your numbers>"`)
+	}
+
+	// Skipping the "Card X:" part
+	_, winning, found := numbers.Cut(":")
+	if !found {
+		return Card{}, numbers.Errorf(`"Card <id>:" before the winning numbers`)
+	}
+	winningNumbers, err := parseNumbers(winning)
+	if err != nil {
+		return Card{}, err
+	}
+	yourNumbers, err := parseNumbers(yours)
+	if err != nil {
+		return Card{}, err
+	}
+
+	return Card{
+		WinningNumbers: winningNumbers,
+		YourNumbers:    yourNumbers,
+	}, nil
+}
+
+func parseNumbers(numbers input.Span) ([]int, error) {
+	return numbers.Ints()
+}
+
+func calculatePoints(card Card) int {
+	points := 0
+	matchFound := false
+	for _, num := range card.YourNumbers {
+		if contains(card.WinningNumbers, num) {
+			if matchFound {
+				points *= 2
+			} else {
+				points = 1
+				matchFound = true
+			}
+		}
+	}
+	return points
+}
+
+func contains(slice []int, val int) bool {
+	for _, item := range slice {
+		if item == val {
+			return true
+		}
+	}
+	return false
+}
+func totalPoints(cards []Card) int {
+	total := 0
+	for _, card := range cards {
+		total += calculatePoints(card)
+	}
+	return total
+}
+
+// Part1 sums the points won by every scratchcard.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	cards, err := readCards(r)
+	if err != nil {
+		return 0, err
+	}
+
+	return totalPoints(cards), nil
+}
@@ -0,0 +1,78 @@
+package day4
+
+import (
+	"context"
+	"io"
+	"math/big"
+
+	"adventofcode23/aoc"
+	"adventofcode23/checked"
+)
+
+// Part2 counts the scratchcards held once every won copy has been processed.
+func Part2(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+	cards, err := readCards(r)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+
+	if total, ok, err := processCards(ctx, cards); err != nil || ok {
+		return aoc.Int(total), err
+	}
+	return aoc.Big(processCardsBig(cards)), nil
+}
+
+func countMatches(card Card) int {
+	matches := 0
+	for _, num := range card.YourNumbers {
+		if contains(card.WinningNumbers, num) {
+			matches++
+		}
+	}
+	return matches
+}
+
+// processCards plays out the cards, handing every copy of a card its
+// winnings at once. It reports false if the count overflows an int.
+func processCards(ctx context.Context, cards []Card) (int, bool, error) {
+	totalCards := 0
+	cardCounts := make([]int, len(cards))
+	for i := range cardCounts {
+		cardCounts[i] = 1 // Initialize with 1 for each original card
+	}
+
+	for i, card := range cards {
+		if err := aoc.Canceled(ctx, "card %d of %d after %d scratchcards", i+1, len(cards), totalCards); err != nil {
+			return 0, false, err
+		}
+		var ok bool
+		if totalCards, ok = checked.Add(totalCards, cardCounts[i]); !ok {
+			return 0, false, nil
+		}
+		matches := countMatches(card)
+		for j := 1; j <= matches && (i+j) < len(cards); j++ {
+			if cardCounts[i+j], ok = checked.Add(cardCounts[i+j], cardCounts[i]); !ok {
+				return 0, false, nil
+			}
+		}
+	}
+	return totalCards, true, nil
+}
+
+// processCardsBig is processCards with big integer counts.
+func processCardsBig(cards []Card) *big.Int {
+	totalCards := new(big.Int)
+	cardCounts := make([]*big.Int, len(cards))
+	for i := range cardCounts {
+		cardCounts[i] = big.NewInt(1)
+	}
+
+	for i, card := range cards {
+		totalCards.Add(totalCards, cardCounts[i])
+		matches := countMatches(card)
+		for j := 1; j <= matches && (i+j) < len(cards); j++ {
+			cardCounts[i+j].Add(cardCounts[i+j], cardCounts[i])
+		}
+	}
+	return totalCards
+}
@@ -0,0 +1,46 @@
+package day4
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+// generate writes size scratchcards with 10 winning numbers and 25 of
+// yours, each list drawn without repeats from 1 to 99 as on the real cards.
+// Cards near the end win fewer copies, so no card wins past the table.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	bw := bufio.NewWriter(w)
+	for id := 1; id <= size; id++ {
+		fmt.Fprintf(bw, "Card %3d:", id)
+		winning := rng.Perm(99)[:10]
+		for _, n := range winning {
+			fmt.Fprintf(bw, " %2d", n+1)
+		}
+		bw.WriteString(" |")
+
+		// Take the matches from the winning numbers and the rest from
+		// the numbers that do not win.
+		matches := rng.Intn(1 + min(10, size-id))
+		won := make(map[int]bool)
+		for _, n := range winning {
+			won[n] = true
+		}
+		yours := append([]int(nil), winning[:matches]...)
+		for _, n := range rng.Perm(99) {
+			if len(yours) == 25 {
+				break
+			}
+			if !won[n] {
+				yours = append(yours, n)
+			}
+		}
+		rng.Shuffle(len(yours), func(i, j int) { yours[i], yours[j] = yours[j], yours[i] })
+		for _, n := range yours {
+			fmt.Fprintf(bw, " %2d", n+1)
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,206 @@
+// Package day5 solves Day 5: If You Give A Seed A Fertilizer.
+package day5
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+const title = "If You Give A Seed A Fertilizer"
+
+func init() {
+	aoc.RegisterParser(5, parseAlmanac)
+	aoc.RegisterGenerator(5, generate)
+	aoc.Register(aoc.New(5, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(5, 2, title, aoc.IntFunc(Part2)))
+}
+
+// Transformation represents a single map, e.g., seed-to-soil.
+type Transformation struct {
+	DestStart   int
+	SourceStart int
+	Length      int
+}
+
+// TransformNumber transforms a given number based on a list of transformations.
+func TransformNumber(number int, transformations []Transformation) int {
+	for _, t := range transformations {
+		if number >= t.SourceStart && number < t.SourceStart+t.Length {
+			// Calculate the offset within the source range
+			offset := number - t.SourceStart
+			// Return the corresponding number in the destination range
+			return t.DestStart + offset
+		}
+	}
+	// If the number does not match any range, return it unchanged
+	return number
+}
+
+// lineScanner is a bufio.Scanner that counts lines so errors can say where they are.
+type lineScanner struct {
+	*bufio.Scanner
+	line int
+}
+
+func newLineScanner(r io.Reader) *lineScanner {
+	return &lineScanner{Scanner: input.NewScanner(r)}
+}
+
+func (s *lineScanner) Scan() bool {
+	if !s.Scanner.Scan() {
+		return false
+	}
+	s.line++
+	return true
+}
+
+// Span returns the current line.
+func (s *lineScanner) Span() input.Span {
+	return input.NewSpan(s.line, s.Text())
+}
+
+// readSeeds reads the "seeds: ..." line that opens the almanac.
+func readSeeds(scanner *lineScanner) ([]int, input.Span, error) {
+	if !scanner.Scan() {
+		if err := scanner.Err(); err != nil {
+			return nil, input.Span{}, err
+		}
+		return nil, input.Span{}, &input.ParseError{Line: 1, Want: `"seeds: <numbers>"`}
+	}
+	line := scanner.Span()
+	seedsLine, found := line.CutPrefix("seeds: ")
+	if !found {
+		return nil, line, line.Errorf(`"seeds: <numbers>"`)
+	}
+	seeds, err := seedsLine.Ints()
+	if err != nil {
+		return nil, line, err
+	}
+	if len(seeds) == 0 {
+		return nil, line, seedsLine.Errorf("at least one seed")
+	}
+	return seeds, seedsLine, nil
+}
+
+// readMapAfterTitle reads the transformations from the file starting after the specified title until a blank line.
+func readMapAfterTitle(scanner *lineScanner, title string) ([]Transformation, error) {
+	var transformations []Transformation
+
+	// Skip lines until the title is found
+	found := false
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) == title {
+			found = true
+			break
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, &input.ParseError{Line: scanner.line + 1, Want: fmt.Sprintf("%q", title)}
+	}
+
+	// Read transformations until a blank line
+	for scanner.Scan() {
+		line := scanner.Span().TrimSpace()
+		if line.Text == "" {
+			break // Stop reading at a blank line
+		}
+		parts := line.Fields()
+		if len(parts) != 3 {
+			return nil, line.Errorf(`"<destination start> <source start> <length>"`)
+		}
+		destStart, err := parts[0].Int()
+		if err != nil {
+			return nil, err
+		}
+		sourceStart, err := parts[1].Int()
+		if err != nil {
+			return nil, err
+		}
+		length, err := parts[2].Int()
+		if err != nil {
+			return nil, err
+		}
+		transformations = append(transformations, Transformation{destStart, sourceStart, length})
+	}
+
+	return transformations, scanner.Err()
+}
+
+// mapTitles lists the almanac's maps in the order a seed passes through them.
+var mapTitles = []string{
+	"seed-to-soil map:",
+	"soil-to-fertilizer map:",
+	"fertilizer-to-water map:",
+	"water-to-light map:",
+	"light-to-temperature map:",
+	"temperature-to-humidity map:",
+	"humidity-to-location map:",
+}
+
+// readMaps reads every map listed in mapTitles, in order.
+func readMaps(scanner *lineScanner) ([][]Transformation, error) {
+	maps := make([][]Transformation, len(mapTitles))
+	for i, title := range mapTitles {
+		transformations, err := readMapAfterTitle(scanner, title)
+		if err != nil {
+			return nil, err
+		}
+		maps[i] = transformations
+	}
+	return maps, nil
+}
+
+// parseAlmanac reads the seeds and maps without using them.
+func parseAlmanac(r io.Reader) error {
+	scanner := newLineScanner(r)
+	if _, _, err := readSeeds(scanner); err != nil {
+		return err
+	}
+	_, err := readMaps(scanner)
+	return err
+}
+
+// findLocation runs a seed through every map and returns its location number.
+func findLocation(seed int, maps [][]Transformation) int {
+	number := seed
+	for _, transformations := range maps {
+		number = TransformNumber(number, transformations)
+	}
+	return number
+}
+
+// Part1 finds the lowest location number of any of the initial seeds.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	scanner := newLineScanner(r)
+
+	// Read seeds
+	seeds, _, err := readSeeds(scanner)
+	if err != nil {
+		return 0, err
+	}
+
+	// Read transformation maps
+	maps, err := readMaps(scanner)
+	if err != nil {
+		return 0, err
+	}
+
+	// Transform seeds to locations and find the lowest location number
+	lowestLocation := findLocation(seeds[0], maps)
+	for _, seed := range seeds[1:] {
+		if loc := findLocation(seed, maps); loc < lowestLocation {
+			lowestLocation = loc
+		}
+	}
+
+	return lowestLocation, nil
+}
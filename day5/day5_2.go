@@ -0,0 +1,52 @@
+package day5
+
+import (
+	"context"
+	"io"
+
+	"adventofcode23/aoc"
+)
+
+// Part2 is Part1 with the seeds line read as pairs of range start and length.
+func Part2(ctx context.Context, r io.Reader) (int, error) {
+	scanner := newLineScanner(r)
+
+	// Read and generate seeds
+	pairs, seedsLine, err := readSeeds(scanner)
+	if err != nil {
+		return 0, err
+	}
+	if len(pairs)%2 != 0 {
+		return 0, seedsLine.End().Errorf("a length after range start %d", pairs[len(pairs)-1])
+	}
+	// Read transformation maps
+	maps, err := readMaps(scanner)
+	if err != nil {
+		return 0, err
+	}
+
+	// Transform every seed in each range to a location and find the lowest
+	// location number
+	total := 0
+	for i := 1; i < len(pairs); i += 2 {
+		total += pairs[i]
+	}
+	minLocation, done := -1, 0
+	for i := 0; i < len(pairs); i += 2 {
+		start, length := pairs[i], pairs[i+1]
+		for j := 0; j < length; j++ {
+			if done%aoc.CheckEvery == 0 {
+				if err := aoc.Canceled(ctx, "seed %d of %d (range %d of %d), lowest location so far %d", done, total, i/2+1, len(pairs)/2, minLocation); err != nil {
+					return 0, err
+				}
+			}
+			location := findLocation(start+j, maps)
+			if minLocation == -1 || location < minLocation {
+				minLocation = location
+			}
+			done++
+		}
+	}
+
+	return minLocation, nil
+}
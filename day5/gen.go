@@ -0,0 +1,44 @@
+package day5
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"sort"
+)
+
+// generate writes an almanac whose maps have size ranges each. Source
+// ranges within a map never overlap, so every seed has one location. There
+// are 1+size/4 seed ranges of at most 1000 seeds, which keeps the brute
+// force of part 2 short.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	const limit = 1 << 32 // numbers stay below this, as in the real input
+	bw := bufio.NewWriter(w)
+
+	bw.WriteString("seeds:")
+	for i := 0; i < 1+size/4; i++ {
+		fmt.Fprintf(bw, " %d %d", rng.Int63n(limit-1000), 1+rng.Intn(1000))
+	}
+	bw.WriteString("\n")
+
+	for _, title := range mapTitles {
+		fmt.Fprintf(bw, "\n%s\n", title)
+		cuts := make([]int, 0, 2*size)
+		seen := make(map[int]bool)
+		for len(cuts) < 2*size {
+			c := int(rng.Int63n(limit))
+			if !seen[c] {
+				seen[c] = true
+				cuts = append(cuts, c)
+			}
+		}
+		sort.Ints(cuts)
+		order := rng.Perm(size)
+		for _, i := range order {
+			src, length := cuts[2*i], cuts[2*i+1]-cuts[2*i]
+			fmt.Fprintf(bw, "%d %d %d\n", rng.Int63n(int64(limit-length)), src, length)
+		}
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,136 @@
+// Package day6 solves Day 6: Wait For It.
+package day6
+
+import (
+	"context"
+	_ "embed"
+	"io"
+
+	"adventofcode23/aoc"
+	"adventofcode23/checked"
+	"adventofcode23/input"
+)
+
+//go:embed example.txt
+var example string
+
+const title = "Wait For It"
+
+func init() {
+	input.RegisterExample(6, example)
+	aoc.RegisterParser(6, parseSheet)
+	aoc.RegisterGenerator(6, generate)
+	aoc.Register(aoc.New(6, 1, title, Part1))
+	aoc.Register(aoc.NewVariant(6, 1, "quadratic", title, Part1Quadratic))
+	aoc.Register(aoc.New(6, 2, title, aoc.IntFunc(Part2)))
+	aoc.Register(aoc.NewVariant(6, 2, "quadratic", title, aoc.IntFunc(Part2Quadratic)))
+	aoc.RegisterReference(6, 1, aoc.DefaultVariant)
+	aoc.RegisterReference(6, 2, aoc.DefaultVariant)
+	aoc.RegisterSizeLimit(6, 2, 4)
+}
+
+// race is one boat race: its duration and the distance to beat.
+type race struct {
+	time   int
+	record int
+}
+
+// waysFunc counts the ways to beat the record of one race.
+type waysFunc func(ctx context.Context, raceTime, recordDistance int) (int, error)
+
+// Calculates the number of ways to beat the record for a single race.
+func waysToBeatRecord(ctx context.Context, raceTime, recordDistance int) (int, error) {
+	ways := 0
+	for buttonHoldTime := 0; buttonHoldTime < raceTime; buttonHoldTime++ {
+		if buttonHoldTime%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "hold time %d of %d", buttonHoldTime, raceTime); err != nil {
+				return 0, err
+			}
+		}
+		speed := buttonHoldTime
+		moveTime := raceTime - buttonHoldTime
+		// A distance too large for an int certainly beats the record
+		distance, ok := checked.Mul(speed, moveTime)
+		if !ok || distance > recordDistance {
+			ways++
+		}
+	}
+	return ways, nil
+}
+
+// readSheet returns the fields after the "Time:" and "Distance:" labels.
+func readSheet(r io.Reader) (times, distances []input.Span, err error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, nil, err
+	}
+	var distanceLine input.Span
+	for _, line := range input.Spans(lines) {
+		if rest, ok := line.CutPrefix("Time:"); ok {
+			times = rest.Fields()
+		} else if rest, ok := line.CutPrefix("Distance:"); ok {
+			distances = rest.Fields()
+			distanceLine = line
+		} else if line.TrimSpace().Text != "" {
+			return nil, nil, line.Errorf(`"Time: <numbers>" or "Distance: <numbers>"`)
+		}
+	}
+	if len(times) == 0 {
+		return nil, nil, &input.ParseError{Line: len(lines) + 1, Want: `"Time: <numbers>"`}
+	}
+	if len(times) != len(distances) {
+		return nil, nil, distanceLine.Errorf("%d distances, one per time", len(times))
+	}
+	return times, distances, nil
+}
+
+// parseSheet reads the sheet without using it.
+func parseSheet(r io.Reader) error {
+	_, _, err := readSheet(r)
+	return err
+}
+
+// Part1 multiplies together the number of ways to beat the record in each race.
+// The product is computed with big integers if it overflows an int.
+func Part1(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+	return productOfWays(ctx, r, waysToBeatRecord)
+}
+
+// Part1Quadratic answers Part1 by solving for the hold times that tie the
+// record instead of trying each one.
+func Part1Quadratic(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+	return productOfWays(ctx, r, waysFromRoots)
+}
+
+// productOfWays multiplies the ways to beat each race's record, as counted
+// by ways.
+func productOfWays(ctx context.Context, r io.Reader, ways waysFunc) (aoc.Answer, error) {
+	times, distances, err := readSheet(r)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+
+	var races []race
+	for i := range times {
+		t, err := times[i].Int()
+		if err != nil {
+			return aoc.Answer{}, err
+		}
+		d, err := distances[i].Int()
+		if err != nil {
+			return aoc.Answer{}, err
+		}
+		races = append(races, race{t, d})
+	}
+
+	var totalWays checked.Product
+	for _, race := range races {
+		n, err := ways(ctx, race.time, race.record)
+		if err != nil {
+			return aoc.Answer{}, err
+		}
+		totalWays.Mul(n)
+	}
+
+	return aoc.Big(totalWays.Big()), nil
+}
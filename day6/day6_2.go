@@ -0,0 +1,54 @@
+package day6
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"adventofcode23/input"
+)
+
+// Part2 reads the sheet as a single race, ignoring the spaces between digits.
+func Part2(ctx context.Context, r io.Reader) (int, error) {
+	return waysForJoinedRace(ctx, r, waysToBeatRecord)
+}
+
+// Part2Quadratic answers Part2 by solving for the hold times that tie the
+// record instead of trying each one.
+func Part2Quadratic(ctx context.Context, r io.Reader) (int, error) {
+	return waysForJoinedRace(ctx, r, waysFromRoots)
+}
+
+// waysForJoinedRace counts, with ways, the ways to beat the record of the
+// race read from the sheet with the spaces removed.
+func waysForJoinedRace(ctx context.Context, r io.Reader, ways waysFunc) (int, error) {
+	times, distances, err := readSheet(r)
+	if err != nil {
+		return 0, err
+	}
+
+	t, err := joinDigits(times)
+	if err != nil {
+		return 0, err
+	}
+	d, err := joinDigits(distances)
+	if err != nil {
+		return 0, err
+	}
+
+	return ways(ctx, t, d)
+}
+
+// joinDigits reads the fields of a line as one number with the spaces removed.
+func joinDigits(fields []input.Span) (int, error) {
+	var digits []string
+	for _, f := range fields {
+		if err := f.CheckChars("0123456789"); err != nil {
+			return 0, err
+		}
+		digits = append(digits, f.Text)
+	}
+	whole := fields[0]
+	whole.Text = strings.Join(digits, "")
+	return whole.Int()
+}
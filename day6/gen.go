@@ -0,0 +1,34 @@
+package day6
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+// generate writes a sheet of size races lasting 2 to 99 milliseconds, like
+// the real ones, each with a record below the best possible distance.
+// Part 2 reads the sheet as one race, so with more than four races its
+// numbers grow too long to scan or to fit in an int; larger sheets are for
+// part 1, and day6.go registers that limit.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	times, records := make([]int, size), make([]int, size)
+	for i := range times {
+		times[i] = 2 + rng.Intn(98)
+		best := (times[i] / 2) * (times[i] - times[i]/2)
+		records[i] = rng.Intn(best)
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.WriteString("Time:    ")
+	for _, t := range times {
+		fmt.Fprintf(bw, " %6d", t)
+	}
+	bw.WriteString("\nDistance:")
+	for _, d := range records {
+		fmt.Fprintf(bw, " %6d", d)
+	}
+	bw.WriteByte('\n')
+	return bw.Flush()
+}
@@ -0,0 +1,46 @@
+package day6
+
+import (
+	"context"
+	"math"
+
+	"adventofcode23/checked"
+)
+
+// waysFromRoots counts the hold times h with h*(raceTime-h) > recordDistance
+// from the roots of h*(raceTime-h) = recordDistance. The floating-point
+// roots are only a starting point; each end is then moved to the first and
+// last hold time that really beats the record. Races too long for the
+// discriminant to fit in an int are counted by waysToBeatRecord.
+func waysFromRoots(ctx context.Context, raceTime, recordDistance int) (int, error) {
+	square, ok := checked.Mul(raceTime, raceTime)
+	if !ok || recordDistance < 0 {
+		return waysToBeatRecord(ctx, raceTime, recordDistance)
+	}
+	fourRecords, ok := checked.Mul(4, recordDistance)
+	if !ok {
+		return 0, nil // far beyond the best distance, raceTime*raceTime/4
+	}
+	disc := square - fourRecords
+	if disc <= 0 {
+		return 0, nil // the best hold time at most ties the record
+	}
+	root := math.Sqrt(float64(disc))
+	beats := func(h int) bool { return h*(raceTime-h) > recordDistance }
+
+	lo := max(0, int((float64(raceTime)-root)/2))
+	for lo > 0 && beats(lo-1) {
+		lo--
+	}
+	for lo < raceTime && !beats(lo) {
+		lo++
+	}
+	hi := min(raceTime-1, int((float64(raceTime)+root)/2))
+	for hi < raceTime-1 && beats(hi+1) {
+		hi++
+	}
+	for hi >= lo && !beats(hi) {
+		hi--
+	}
+	return max(0, hi-lo+1), nil
+}
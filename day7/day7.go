@@ -0,0 +1,161 @@
+// Package day7 solves Day 7: Camel Cards.
+package day7
+
+import (
+	"context"
+	"io"
+	"sort"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+const title = "Camel Cards"
+
+func init() {
+	aoc.RegisterParser(7, aoc.ParseOnly(readHands))
+	aoc.RegisterGenerator(7, generate)
+	aoc.Register(aoc.New(7, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(7, 2, title, aoc.IntFunc(Part2)))
+}
+
+// Hand represents a poker hand and its bid
+type Hand struct {
+	Cards string
+	Bid   int
+	Rank  int
+}
+
+// ByStrength implements sort.Interface based on the strength of the hands
+type ByStrength []Hand
+
+func (h ByStrength) Len() int {
+	return len(h)
+}
+
+func (h ByStrength) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h ByStrength) Less(i, j int) bool {
+	typeI, strengthI := evaluateHand(h[i])
+	typeJ, strengthJ := evaluateHand(h[j])
+
+	if typeI != typeJ {
+		return typeI < typeJ // Assuming higher type numbers are stronger
+	}
+
+	// Compare strengths if types are the same
+	for k := range strengthI {
+		if strengthI[k] != strengthJ[k] {
+			return strengthI[k] > strengthJ[k]
+		}
+	}
+
+	return false
+}
+
+func parseInput(lines []string) ([]Hand, error) {
+	var hands []Hand
+	for _, line := range input.Spans(lines) {
+		parts := line.Fields()
+		if len(parts) != 2 {
+			return nil, line.Errorf(`"<cards> <bid>"`)
+		}
+		cards := parts[0]
+		if err := cards.CheckChars("AKQJT98765432"); err != nil {
+			return nil, err
+		}
+		if len(cards.Text) != 5 {
+			return nil, cards.Errorf("five cards")
+		}
+		bid, err := parts[1].Int()
+		if err != nil {
+			return nil, err
+		}
+		hands = append(hands, Hand{Cards: cards.Text, Bid: bid})
+	}
+	return hands, nil
+}
+
+// cardStrength maps a card to its strength
+var cardStrength = map[rune]int{
+	'A': 14, 'K': 13, 'Q': 12, 'J': 11, 'T': 10,
+	'9': 9, '8': 8, '7': 7, '6': 6, '5': 5, '4': 4, '3': 3, '2': 2,
+}
+
+func evaluateHand(hand Hand) (handType int, strength []int) {
+	counts := make(map[rune]int)
+	for _, card := range hand.Cards {
+		counts[card]++
+	}
+
+	var pairCount, tripleCount, quadCount, fiveCount int
+	for _, count := range counts {
+		switch count {
+		case 2:
+			pairCount++
+		case 3:
+			tripleCount++
+		case 4:
+			quadCount++
+		case 5:
+			fiveCount++
+		}
+	}
+
+	// 记录手牌中每张牌的顺序和强度
+	for _, card := range hand.Cards {
+		strength = append(strength, cardStrength[card])
+	}
+
+	// 根据手牌类型设置handType
+	switch {
+	case fiveCount == 1:
+		handType = 1 // five of a kind
+	case quadCount == 1:
+		handType = 2 // Four of a kind
+	case tripleCount == 1 && pairCount == 1:
+		handType = 3 // Full house
+	case tripleCount == 1:
+		handType = 4 // Three of a kind
+	case pairCount == 2:
+		handType = 5 // Two pair
+	case pairCount == 1:
+		handType = 6 // One pair
+	default:
+		handType = 7 // High card
+	}
+
+	return handType, strength
+}
+
+// readHands reads one hand and its bid per line.
+func readHands(r io.Reader) ([]Hand, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	return parseInput(lines)
+}
+
+// totalWinnings sums each hand's bid times its rank; hands must be sorted strongest first.
+func totalWinnings(hands []Hand) int {
+	total := 0
+	for i, hand := range hands {
+		rank := len(hands) - i // 从末尾开始计数，最强的手牌获得最高等级
+		total += hand.Bid * rank
+	}
+	return total
+}
+
+// Part1 returns the total winnings of the set of hands.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	hands, err := readHands(r)
+	if err != nil {
+		return 0, err
+	}
+
+	sort.Sort(ByStrength(hands))
+	return totalWinnings(hands), nil
+}
@@ -1,35 +1,26 @@
-package main
+package day7
 
 import (
-	"bufio"
-	"fmt"
-	"os"
+	"context"
+	"io"
 	"sort"
-	"strconv"
-	"strings"
 )
 
-// Hand represents a poker hand and its bid
-type Hand struct {
-	Cards string
-	Bid   int
-	Rank  int
-}
-
-// ByStrength implements sort.Interface based on the strength of the hands
-type ByStrength []Hand
+// ByJokerStrength implements sort.Interface based on the strength of the hands,
+// with 'J' read as a joker.
+type ByJokerStrength []Hand
 
-func (h ByStrength) Len() int {
+func (h ByJokerStrength) Len() int {
 	return len(h)
 }
 
-func (h ByStrength) Swap(i, j int) {
+func (h ByJokerStrength) Swap(i, j int) {
 	h[i], h[j] = h[j], h[i]
 }
 
-func (h ByStrength) Less(i, j int) bool {
-	typeI, strengthI := evaluateHand(h[i])
-	typeJ, strengthJ := evaluateHand(h[j])
+func (h ByJokerStrength) Less(i, j int) bool {
+	typeI, strengthI := evaluateJokerHand(h[i])
+	typeJ, strengthJ := evaluateJokerHand(h[j])
 
 	if typeI != typeJ {
 		return typeI < typeJ // Assuming higher type numbers are stronger
@@ -45,31 +36,7 @@ func (h ByStrength) Less(i, j int) bool {
 	return false
 }
 
-func parseInput(input []string) []Hand {
-	var hands []Hand
-	for _, line := range input {
-		parts := strings.Split(line, " ")
-		if len(parts) != 2 {
-			fmt.Println("Invalid input format")
-			continue
-		}
-		bid, err := strconv.Atoi(parts[1])
-		if err != nil {
-			fmt.Printf("Invalid bid format: %s\n", parts[1])
-			continue
-		}
-		hands = append(hands, Hand{Cards: parts[0], Bid: bid})
-	}
-	return hands
-}
-
-// cardStrength maps a card to its strength
-var cardStrength = map[rune]int{
-	'A': 14, 'K': 13, 'Q': 12, 'J': 11, 'T': 10,
-	'9': 9, '8': 8, '7': 7, '6': 6, '5': 5, '4': 4, '3': 3, '2': 2,
-}
-
-func evaluateHand(hand Hand) (handType int, strength []int) {
+func evaluateJokerHand(hand Hand) (handType int, strength []int) {
 	counts := make(map[rune]int)
 	jokerCount := 0
 
@@ -179,41 +146,13 @@ func evaluateHand(hand Hand) (handType int, strength []int) {
 //	return handType, strength
 //}
 
-func main() {
-	file, err := os.Open("day7.txt")
+// Part2 is Part1 with 'J' cards acting as jokers.
+func Part2(ctx context.Context, r io.Reader) (int, error) {
+	hands, err := readHands(r)
 	if err != nil {
-		fmt.Printf("Error opening file: %s\n", err)
-		return
-	}
-	defer file.Close()
-
-	var hands []Hand
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		parts := strings.Fields(scanner.Text())
-		if len(parts) != 2 {
-			continue // skip invalid lines
-		}
-		bid, err := strconv.Atoi(parts[1])
-		if err != nil {
-			continue // skip invalid lines
-		}
-		hands = append(hands, Hand{Cards: parts[0], Bid: bid})
+		return 0, err
 	}
 
-	if err := scanner.Err(); err != nil {
-		fmt.Printf("Error reading file: %s\n", err)
-		return
-	}
-
-	sort.Sort(ByStrength(hands))
-
-	totalWinnings := 0
-	for i, hand := range hands {
-		rank := len(hands) - i // 从末尾开始计数，最强的手牌获得最高等级
-		fmt.Println(hand.Bid)
-		fmt.Println(rank)
-		totalWinnings += hand.Bid * rank
-	}
-	fmt.Printf("Total Winnings: %d\n", totalWinnings)
+	sort.Sort(ByJokerStrength(hands))
+	return totalWinnings(hands), nil
 }
@@ -0,0 +1,30 @@
+package day7
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+// generate writes size hands with bids from 1 to 1000. The hands are all
+// different, as in the real input, so the ranking has no ties; size is
+// capped at the number of possible hands.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	const cards = "AKQJT98765432"
+	bw := bufio.NewWriter(w)
+	size = min(size, 13*13*13*13*13)
+	seen := make(map[string]bool, size)
+	for len(seen) < size {
+		var hand [5]byte
+		for i := range hand {
+			hand[i] = cards[rng.Intn(len(cards))]
+		}
+		if seen[string(hand[:])] {
+			continue
+		}
+		seen[string(hand[:])] = true
+		fmt.Fprintf(bw, "%s %d\n", hand[:], 1+rng.Intn(1000))
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,416 @@
+// Package day8 solves Day 8: Haunted Wasteland.
+package day8
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"adventofcode23/aoc"
+	"adventofcode23/checked"
+	"adventofcode23/input"
+)
+
+const title = "Haunted Wasteland"
+
+func init() {
+	aoc.RegisterParser(8, parseNetwork)
+	aoc.RegisterGenerator(8, generate)
+	aoc.Register(aoc.New(8, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(8, 2, title, Part2))
+	aoc.Register(aoc.NewVariant(8, 2, "brute", title, aoc.IntFunc(Part2Brute)))
+	aoc.RegisterReference(8, 2, "brute")
+}
+
+type Node struct {
+	ID    string
+	Left  *Node
+	Right *Node
+}
+
+// CreateGraphFromReader reads the instruction line and the node network.
+func CreateGraphFromReader(r io.Reader) (map[string]*Node, string, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, "", err
+	}
+	nodes := make(map[string]*Node)
+	var instructions string
+	// The children named on each line, checked once every node is read.
+	type use struct {
+		line        input.Span
+		left, right string
+	}
+	var uses []use
+
+	for _, line := range input.Spans(lines) {
+		if strings.Contains(line.Text, "=") {
+			// Process node line
+			nodeID, leftChild, rightChild, err := parseNodeLine(line)
+			if err != nil {
+				return nil, "", err
+			}
+
+			// Initialize nodes if not already
+			if _, ok := nodes[nodeID]; !ok {
+				nodes[nodeID] = &Node{ID: nodeID}
+			}
+			if _, ok := nodes[leftChild]; !ok {
+				nodes[leftChild] = &Node{ID: leftChild}
+			}
+			if _, ok := nodes[rightChild]; !ok {
+				nodes[rightChild] = &Node{ID: rightChild}
+			}
+
+			// Set children
+			nodes[nodeID].Left = nodes[leftChild]
+			nodes[nodeID].Right = nodes[rightChild]
+			uses = append(uses, use{line, leftChild, rightChild})
+		} else if line.Text != "" {
+			// This line should contain the instructions
+			if err := line.CheckChars("LR"); err != nil {
+				return nil, "", err
+			}
+			instructions = line.Text
+		}
+	}
+
+	if instructions == "" {
+		return nil, "", &input.ParseError{Line: 1, Want: "a line of L and R instructions"}
+	}
+	// Every node must have children to follow, or the walks would stop on
+	// a nil one.
+	for _, u := range uses {
+		for _, id := range []string{u.left, u.right} {
+			if nodes[id].Left == nil {
+				return nil, "", u.line.Errorf("a network that defines node %s", id)
+			}
+		}
+	}
+	return nodes, instructions, nil
+}
+
+// parseNetwork reads the instructions and network without using them.
+func parseNetwork(r io.Reader) error {
+	_, _, err := CreateGraphFromReader(r)
+	return err
+}
+
+// parseNodeLine splits "AAA = (BBB, CCC)" into the node and its two children.
+func parseNodeLine(line input.Span) (id, left, right string, err error) {
+	const want = `"<node> = (<left>, <right>)"`
+	before, after, _ := line.Cut("=")
+	node := before.TrimSpace()
+	children := after.TrimSpace()
+	inner, ok := children.CutPrefix("(")
+	if !ok || !strings.HasSuffix(inner.Text, ")") {
+		return "", "", "", children.Errorf(want)
+	}
+	inner = inner.Sub(0, len(inner.Text)-1)
+	leftSpan, rightSpan, found := inner.Cut(",")
+	if !found {
+		return "", "", "", inner.Errorf(`"<left>, <right>"`)
+	}
+	leftSpan, rightSpan = leftSpan.TrimSpace(), rightSpan.TrimSpace()
+	for _, name := range []input.Span{node, leftSpan, rightSpan} {
+		if name.Text == "" || strings.ContainsAny(name.Text, " (),=") {
+			return "", "", "", name.Errorf("a node name in %s", want)
+		}
+	}
+	return node.Text, leftSpan.Text, rightSpan.Text, nil
+}
+
+func CreateGraph() map[string]*Node {
+	nodes := make(map[string]*Node)
+
+	// Initialize nodes
+	ids := []string{"AAA", "BBB", "ZZZ"}
+	for _, id := range ids {
+		nodes[id] = &Node{ID: id}
+	}
+
+	// Set children for the provided example
+	nodes["AAA"].Left, nodes["AAA"].Right = nodes["BBB"], nodes["BBB"]
+	nodes["BBB"].Left, nodes["BBB"].Right = nodes["AAA"], nodes["ZZZ"]
+	nodes["ZZZ"].Left, nodes["ZZZ"].Right = nodes["ZZZ"], nodes["ZZZ"]
+
+	return nodes
+}
+
+func TraverseGraph(ctx context.Context, start *Node, instructions string) (int, error) {
+	currentNode := start
+	steps := 0
+
+	for currentNode.ID != "ZZZ" {
+		if steps%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "step %d on node %s", steps, currentNode.ID); err != nil {
+				return 0, err
+			}
+		}
+		steps++
+		instruction := instructions[(steps-1)%len(instructions)]
+
+		previous := currentNode
+		if instruction == 'L' {
+			currentNode = currentNode.Left
+		} else { // Assuming 'R'
+			currentNode = currentNode.Right
+		}
+
+		// Safeguard against nodes that are named but never defined
+		if currentNode == nil {
+			return 0, fmt.Errorf("step %d: node %s is never defined", steps, previous.ID)
+		}
+	}
+
+	return steps, nil
+}
+
+func TraverseAll(ctx context.Context, graph map[string]*Node, instructions string) (int, error) {
+	log := aoc.Logger(ctx)
+
+	// Find all starting nodes (nodes ending with 'A')
+	var currentNodes []*Node
+	for _, node := range graph {
+		if strings.HasSuffix(node.ID, "A") {
+			currentNodes = append(currentNodes, node)
+		}
+	}
+
+	steps := 0
+	for {
+		if steps%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "step %d with %s", steps, countOnZ(currentNodes)); err != nil {
+				return 0, err
+			}
+		}
+		steps++
+		var nextNodes []*Node
+		instruction := instructions[(steps-1)%len(instructions)]
+
+		// Move each current node according to the instruction
+		for _, node := range currentNodes {
+			if instruction == 'L' {
+				nextNodes = append(nextNodes, node.Left)
+			} else { // Assuming 'R'
+				nextNodes = append(nextNodes, node.Right)
+			}
+		}
+
+		// Update current nodes
+		currentNodes = nextNodes
+
+		// Check if all current nodes end with 'Z'
+		allEndWithZ := true
+		for _, node := range currentNodes {
+			if !strings.HasSuffix(node.ID, "Z") {
+				allEndWithZ = false
+				break
+			}
+		}
+		log.Log(ctx, aoc.LevelTrace, "step", "n", steps, "all_on_z", allEndWithZ)
+		if allEndWithZ {
+			return steps, nil
+		}
+	}
+}
+
+// countOnZ describes how many of the nodes end with 'Z', for progress reports.
+func countOnZ(nodes []*Node) string {
+	onZ := 0
+	for _, node := range nodes {
+		if strings.HasSuffix(node.ID, "Z") {
+			onZ++
+		}
+	}
+	return fmt.Sprintf("%d of %d ghosts on a Z node", onZ, len(nodes))
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		t := b
+		b = a % b
+		a = t
+	}
+	return a
+}
+
+// lcm returns the least common multiple of a and b and whether it fits in
+// an int.
+func lcm(a, b int) (int, bool) {
+	return checked.Mul(a/gcd(a, b), b)
+}
+
+// bigLCM is the least common multiple of lengths, for when lcm overflows.
+func bigLCM(lengths []int) *big.Int {
+	result := big.NewInt(1)
+	var g big.Int
+	for _, length := range lengths {
+		n := big.NewInt(int64(length))
+		g.GCD(nil, nil, result, n)
+		result.Mul(result.Div(result, &g), n)
+	}
+	return result
+}
+
+func findCycleLength(ctx context.Context, node *Node, instructions string) (int, error) {
+	seen := make(map[string]int)
+	steps := 0
+	currentNode := node
+
+	for {
+		if steps%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "step %d looking for the cycle from %s", steps, node.ID); err != nil {
+				return 0, err
+			}
+		}
+		state := currentNode.ID + strconv.Itoa(steps%len(instructions))
+		if pos, exists := seen[state]; exists {
+			return steps - pos, nil
+		}
+		seen[state] = steps
+		instruction := instructions[steps%len(instructions)]
+		steps++
+		if instruction == 'L' {
+			currentNode = currentNode.Left
+		} else {
+			currentNode = currentNode.Right
+		}
+	}
+}
+
+// TraverseAllWithLCM answers Part2 from the cycle length of each ghost. An
+// LCM that overflows an int is returned as a big integer without the
+// step-by-step check, which could never reach it.
+func TraverseAllWithLCM(ctx context.Context, graph map[string]*Node, instructions string) (aoc.Answer, error) {
+	// Find cycle lengths for all starting nodes
+	cycleLengths := []int{}
+	for _, node := range graph {
+		if strings.HasSuffix(node.ID, "A") {
+			cycleLength, err := findCycleLength(ctx, node, instructions)
+			if err != nil {
+				return aoc.Answer{}, err
+			}
+			cycleLengths = append(cycleLengths, cycleLength)
+		}
+	}
+
+	// Calculate LCM of cycle lengths
+	overallLCM := 1
+	for _, length := range cycleLengths {
+		var ok bool
+		if overallLCM, ok = lcm(overallLCM, length); !ok {
+			overflowed := bigLCM(cycleLengths)
+			aoc.Logger(ctx).Debug("cycle lengths", "lengths", cycleLengths, "lcm", overflowed, "overflow", true)
+			return aoc.Big(overflowed), nil
+		}
+	}
+	aoc.Logger(ctx).Debug("cycle lengths", "lengths", cycleLengths, "lcm", overallLCM)
+	// Traverse for LCM steps and check if all end with 'Z'
+	steps, err := checkAtLCMStep(ctx, graph, instructions, overallLCM)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	return aoc.Int(steps), nil
+}
+
+// checkAtLCMStep walks every ghost the given number of steps and returns
+// them if all of them end on a Z node. The cycle lengths only give the answer
+// when each ghost first reaches Z after exactly one cycle, as in the puzzle
+// input, so any other network is reported as an error.
+func checkAtLCMStep(ctx context.Context, graph map[string]*Node, instructions string, steps int) (int, error) {
+	// One entry per ghost: two ghosts on the same node are still two ghosts.
+	var currentNodes []*Node
+	for _, node := range graph {
+		if strings.HasSuffix(node.ID, "A") {
+			currentNodes = append(currentNodes, node)
+		}
+	}
+
+	for i := 0; i < steps; i++ {
+		if i%aoc.CheckEvery == 0 {
+			if err := aoc.Canceled(ctx, "step %d of %d", i, steps); err != nil {
+				return 0, err
+			}
+		}
+		instruction := instructions[i%len(instructions)]
+		for g, node := range currentNodes {
+			if instruction == 'L' {
+				currentNodes[g] = node.Left
+			} else { // Assuming 'R'
+				currentNodes[g] = node.Right
+			}
+		}
+	}
+
+	for _, node := range currentNodes {
+		if !strings.HasSuffix(node.ID, "Z") {
+			return 0, fmt.Errorf("not every ghost is on a Z node after %d steps, the LCM of their cycle lengths: %s", steps, countOnZ(currentNodes))
+		}
+	}
+	return steps, nil
+}
+
+// logNetwork logs the size of the network at debug level and every node at
+// trace level.
+func logNetwork(ctx context.Context, graph map[string]*Node, instructions string) {
+	log := aoc.Logger(ctx)
+	log.Debug("network", "nodes", len(graph), "instructions", len(instructions))
+	if !log.Enabled(ctx, aoc.LevelTrace) {
+		return
+	}
+	ids := make([]string, 0, len(graph))
+	for id := range graph {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		node := graph[id]
+		var left, right string
+		if node.Left != nil {
+			left, right = node.Left.ID, node.Right.ID
+		}
+		log.Log(ctx, aoc.LevelTrace, "node", "id", id, "left", left, "right", right)
+	}
+}
+
+// Part1 counts the steps needed to get from AAA to ZZZ.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	graph, instructions, err := CreateGraphFromReader(r)
+	if err != nil {
+		return 0, err
+	}
+	logNetwork(ctx, graph, instructions)
+	start, ok := graph["AAA"]
+	if !ok {
+		return 0, errors.New("no AAA node in network")
+	}
+	return TraverseGraph(ctx, start, instructions)
+}
+
+// Part2 counts the steps until every node ending in A is on a node ending in Z.
+func Part2(ctx context.Context, r io.Reader) (aoc.Answer, error) {
+	graph, instructions, err := CreateGraphFromReader(r)
+	if err != nil {
+		return aoc.Answer{}, err
+	}
+	logNetwork(ctx, graph, instructions)
+
+	return TraverseAllWithLCM(ctx, graph, instructions)
+}
+
+// Part2Brute answers Part2 by stepping every ghost at once until they all stand on a Z node.
+func Part2Brute(ctx context.Context, r io.Reader) (int, error) {
+	graph, instructions, err := CreateGraphFromReader(r)
+	if err != nil {
+		return 0, err
+	}
+	logNetwork(ctx, graph, instructions)
+
+	return TraverseAll(ctx, graph, instructions)
+}
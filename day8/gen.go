@@ -0,0 +1,150 @@
+package day8
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+)
+
+// generate writes a network of roughly size nodes laid out like the real
+// puzzle: every ghost starts on a node ending in A that leads into its own
+// ring, and the ring closes on a node ending in Z, which leads back to the
+// ring's start. Each ring is the instruction count times a small odd prime
+// long, so ghost 0 walks from AAA to ZZZ and every ghost is on Z together
+// after the least common multiple of the ring lengths. As a ring's length is
+// a multiple of the instruction count, a ghost always reaches a node on the
+// same instruction: that one picks the next node of the ring and the other
+// picks a random node, so a solver that misreads the instructions gets lost.
+func generate(w io.Writer, size int, rng *rand.Rand) error {
+	ghosts := min(6, 1+size/50)
+	primes := []int{3, 5, 7, 11, 13, 17}
+	rng.Shuffle(len(primes), func(i, j int) { primes[i], primes[j] = primes[j], primes[i] })
+	primes = primes[:ghosts]
+	sum := 0
+	for _, p := range primes {
+		sum += p
+	}
+	steps := nextPrime(max(2, size/sum))
+	for isAmong(steps, primes) {
+		steps = nextPrime(steps + 1)
+	}
+
+	// Filler names avoid the A and Z endings, which mark starts and ends.
+	names := nameSource(rng, steps*sum)
+	// turn is the index of the instruction a ghost follows out of the node.
+	type line struct {
+		id, next string
+		turn     int
+	}
+	var lines []line
+	for g, p := range primes {
+		start, end := names.special('A'), names.special('Z')
+		if g == 0 {
+			start, end = "AAA", "ZZZ"
+		}
+		first := names.filler()
+		lines = append(lines, line{start, first, 0}, line{end, first, 0})
+		prev := first
+		for i := 2; i < steps*p; i++ {
+			n := names.filler()
+			lines = append(lines, line{prev, n, (i - 1) % steps})
+			prev = n
+		}
+		lines = append(lines, line{prev, end, steps - 1})
+	}
+
+	instructions := make([]byte, steps)
+	for i := range instructions {
+		instructions[i] = "LR"[rng.Intn(2)]
+	}
+	decoys := make([]string, len(lines))
+	for i := range decoys {
+		decoys[i] = lines[rng.Intn(len(lines))].id
+	}
+
+	bw := bufio.NewWriter(w)
+	bw.Write(instructions)
+	bw.WriteString("\n\n")
+	rng.Shuffle(len(lines), func(i, j int) { lines[i], lines[j] = lines[j], lines[i] })
+	for i, l := range lines {
+		left, right := l.next, decoys[i]
+		if instructions[l.turn] == 'R' {
+			left, right = right, left
+		}
+		fmt.Fprintf(bw, "%s = (%s, %s)\n", l.id, left, right)
+	}
+	return bw.Flush()
+}
+
+func nextPrime(n int) int {
+	for ; ; n++ {
+		prime := n >= 2
+		for d := 2; d*d <= n; d++ {
+			if n%d == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			return n
+		}
+	}
+}
+
+func isAmong(n int, list []int) bool {
+	for _, x := range list {
+		if n == x {
+			return true
+		}
+	}
+	return false
+}
+
+// names hands out distinct random node names of the same width.
+type names struct {
+	rng   *rand.Rand
+	width int
+	used  map[string]bool
+}
+
+// nameSource returns names wide enough for n fillers. Names are three
+// characters, as in the real input, unless that is too few.
+func nameSource(rng *rand.Rand, n int) *names {
+	width, capacity := 3, 36*36*34
+	for capacity < 2*n {
+		width++
+		capacity *= 36
+	}
+	return &names{rng: rng, width: width, used: map[string]bool{"AAA": true, "ZZZ": true}}
+}
+
+// special returns a name ending in last.
+func (s *names) special(last byte) string {
+	return s.next(func(b []byte) { b[len(b)-1] = last })
+}
+
+// filler returns a name ending in neither A nor Z.
+func (s *names) filler() string {
+	return s.next(func(b []byte) {
+		for b[len(b)-1] == 'A' || b[len(b)-1] == 'Z' {
+			b[len(b)-1] = nameChars[s.rng.Intn(len(nameChars))]
+		}
+	})
+}
+
+const nameChars = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+func (s *names) next(fix func([]byte)) string {
+	b := make([]byte, s.width)
+	for {
+		for i := range b {
+			b[i] = nameChars[s.rng.Intn(len(nameChars))]
+		}
+		fix(b)
+		if !s.used[string(b)] {
+			s.used[string(b)] = true
+			return string(b)
+		}
+	}
+}
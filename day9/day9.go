@@ -1,13 +1,22 @@
-package main
+// Package day9 solves Day 9: Mirage Maintenance.
+package day9
 
 import (
-	"bufio"
-	"fmt"
-	"os"
-	"strconv"
-	"strings"
+	"context"
+	"io"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
 )
 
+const title = "Mirage Maintenance"
+
+func init() {
+	aoc.RegisterParser(9, aoc.ParseOnly(readInput))
+	aoc.Register(aoc.New(9, 1, title, aoc.IntFunc(Part1)))
+	aoc.Register(aoc.New(9, 2, title, aoc.IntFunc(Part2)))
+}
+
 // Function to calculate the next value in the sequence
 func extrapolateNextValue(history []int) int {
 	sequences := make([][]int, 0)
@@ -36,10 +45,14 @@ func extrapolateNextValue(history []int) int {
 		}
 	}
 
-	// Extrapolate the next value
+	// Extrapolate the next value. A history of one value has no
+	// differences, which count as zero.
 	for i := len(sequences) - 2; i >= 0; i-- {
 		lastValue := sequences[i][len(sequences[i])-1]
-		diffValue := sequences[i+1][len(sequences[i+1])-1]
+		diffValue := 0
+		if next := sequences[i+1]; len(next) > 0 {
+			diffValue = next[len(next)-1]
+		}
 		sequences[i] = append(sequences[i], lastValue+diffValue)
 	}
 
@@ -56,39 +69,33 @@ func calculateSumOfExtrapolatedValues(reports [][]int) int {
 	return sum
 }
 
-func readInput(filePath string) ([][]int, error) {
-	file, err := os.Open(filePath)
+func readInput(r io.Reader) ([][]int, error) {
+	lines, err := input.Lines(r)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
 	var reports [][]int
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		fields := strings.Fields(line)
-		var report []int
-		for _, field := range fields {
-			value, err := strconv.Atoi(field)
-			if err != nil {
-				return nil, err
-			}
-			report = append(report, value)
+	for _, line := range input.Spans(lines) {
+		report, err := line.Ints()
+		if err != nil {
+			return nil, err
+		}
+		if len(report) == 0 {
+			return nil, line.Errorf("a history of at least one value")
 		}
 		reports = append(reports, report)
 	}
 
-	return reports, scanner.Err()
+	return reports, nil
 }
 
-func main() {
-	reports, err := readInput("day9.txt")
+// Part1 sums the next value extrapolated for every history.
+func Part1(ctx context.Context, r io.Reader) (int, error) {
+	reports, err := readInput(r)
 	if err != nil {
-		fmt.Println("Error reading file:", err)
-		return
+		return 0, err
 	}
 
-	sumOfExtrapolatedValues := calculateSumOfExtrapolatedValues(reports)
-	fmt.Printf("Sum of extrapolated values: %d", sumOfExtrapolatedValues)
+	return calculateSumOfExtrapolatedValues(reports), nil
 }
@@ -1,11 +1,8 @@
-package main
+package day9
 
 import (
-	"bufio"
-	"fmt"
-	"os"
-	"strconv"
-	"strings"
+	"context"
+	"io"
 )
 
 // Function to calculate the previous value in the sequence by extrapolating backwards
@@ -36,10 +33,14 @@ func extrapolatePreviousValue(history []int) int {
 		}
 	}
 
-	// Extrapolate the previous value
+	// Extrapolate the previous value. A history of one value has no
+	// differences, which count as zero.
 	for i := len(sequences) - 2; i >= 0; i-- {
 		firstValue := sequences[i][0]
-		diffValue := sequences[i+1][0]
+		diffValue := 0
+		if next := sequences[i+1]; len(next) > 0 {
+			diffValue = next[0]
+		}
 		newFirstValue := firstValue - diffValue
 		sequences[i] = append([]int{newFirstValue}, sequences[i]...)
 	}
@@ -48,36 +49,16 @@ func extrapolatePreviousValue(history []int) int {
 	return sequences[0][0]
 }
 
-// Function to read the file and process the histories
-func processFile(filename string) (int, error) {
-	file, err := os.Open(filename)
+// Part2 sums the previous value extrapolated for every history.
+func Part2(ctx context.Context, r io.Reader) (int, error) {
+	reports, err := readInput(r)
 	if err != nil {
 		return 0, err
 	}
-	defer file.Close()
 
-	scanner := bufio.NewScanner(file)
 	sum := 0
-	for scanner.Scan() {
-		line := scanner.Text()
-		numbers := strings.Fields(line)
-		history := make([]int, len(numbers))
-		for i, n := range numbers {
-			history[i], err = strconv.Atoi(n)
-			if err != nil {
-				return 0, err
-			}
-		}
-		sum += extrapolatePreviousValue(history)
-	}
-	return sum, scanner.Err()
-}
-
-func main() {
-	sum, err := processFile("day9.txt")
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
+	for _, report := range reports {
+		sum += extrapolatePreviousValue(report)
 	}
-	fmt.Printf("Sum of extrapolated previous values: %d\n", sum)
+	return sum, nil
 }
@@ -0,0 +1,21 @@
+// Package days links every day's solvers into the aoc registry. Import it
+// for its side effects:
+//
+//	import _ "adventofcode23/days"
+package days
+
+import (
+	_ "adventofcode23/day1"
+	_ "adventofcode23/day10"
+	_ "adventofcode23/day11"
+	_ "adventofcode23/day12"
+	_ "adventofcode23/day13"
+	_ "adventofcode23/day2"
+	_ "adventofcode23/day3"
+	_ "adventofcode23/day4"
+	_ "adventofcode23/day5"
+	_ "adventofcode23/day6"
+	_ "adventofcode23/day7"
+	_ "adventofcode23/day8"
+	_ "adventofcode23/day9"
+)
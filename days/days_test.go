@@ -0,0 +1,56 @@
+package days
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"adventofcode23/aoc"
+)
+
+// TestGenerators checks that each day's generated inputs are reproducible,
+// pass the day's parser and are solved without error. The sizes stay small
+// enough for every part, including day 6 part 2, which joins the races.
+func TestGenerators(t *testing.T) {
+	for day := 1; day <= 25; day++ {
+		gen, ok := aoc.LookupGenerator(day)
+		if !ok {
+			continue
+		}
+		for _, size := range []int{1, 4} {
+			for seed := int64(1); seed <= 3; seed++ {
+				day, size, seed := day, size, seed
+				t.Run(fmt.Sprintf("day%d/size%d/seed%d", day, size, seed), func(t *testing.T) {
+					t.Parallel()
+					var a, b bytes.Buffer
+					if err := gen(&a, size, rand.New(rand.NewSource(seed))); err != nil {
+						t.Fatal(err)
+					}
+					if err := gen(&b, size, rand.New(rand.NewSource(seed))); err != nil {
+						t.Fatal(err)
+					}
+					if !bytes.Equal(a.Bytes(), b.Bytes()) {
+						t.Fatal("the same seed generated different inputs")
+					}
+
+					if parse, ok := aoc.LookupParser(day); ok {
+						if err := parse(bytes.NewReader(a.Bytes())); err != nil {
+							t.Fatalf("parsing generated input: %v\n%s", err, a.Bytes())
+						}
+					}
+					for _, s := range aoc.Solvers(aoc.Filter{Day: day}) {
+						ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+						_, err := s.Solve(ctx, bytes.NewReader(a.Bytes()))
+						cancel()
+						if err != nil {
+							t.Errorf("%s: %v\n%s", aoc.Name(s), err, a.Bytes())
+						}
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package days
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+// fuzzDay fuzzes a day's registered parser and, on inputs the parser
+// accepts, its solvers. Errors are fine; panics are not. The seed corpus is
+// the committed input, its first lines on their own and the example.
+func fuzzDay(f *testing.F, day int) {
+	parse, ok := aoc.LookupParser(day)
+	if !ok {
+		f.Skipf("no parser registered for day %d", day)
+	}
+	if data, err := os.ReadFile(fmt.Sprintf("../day%d.txt", day)); err == nil {
+		f.Add(data)
+		for i, line := range bytes.SplitAfter(data, []byte("\n")) {
+			if i == 5 {
+				break
+			}
+			f.Add(line)
+		}
+	}
+	if ex, err := input.Open(day, input.Example); err == nil {
+		data, _ := io.ReadAll(ex)
+		ex.Close()
+		f.Add(data)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		if err := parse(bytes.NewReader(data)); err != nil {
+			return
+		}
+		for _, s := range aoc.Solvers(aoc.Filter{Day: day}) {
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			s.Solve(ctx, bytes.NewReader(data))
+			cancel()
+		}
+	})
+}
+
+func FuzzDay1(f *testing.F)  { fuzzDay(f, 1) }
+func FuzzDay2(f *testing.F)  { fuzzDay(f, 2) }
+func FuzzDay3(f *testing.F)  { fuzzDay(f, 3) }
+func FuzzDay4(f *testing.F)  { fuzzDay(f, 4) }
+func FuzzDay5(f *testing.F)  { fuzzDay(f, 5) }
+func FuzzDay6(f *testing.F)  { fuzzDay(f, 6) }
+func FuzzDay7(f *testing.F)  { fuzzDay(f, 7) }
+func FuzzDay8(f *testing.F)  { fuzzDay(f, 8) }
+func FuzzDay9(f *testing.F)  { fuzzDay(f, 9) }
+func FuzzDay10(f *testing.F) { fuzzDay(f, 10) }
+func FuzzDay11(f *testing.F) { fuzzDay(f, 11) }
+func FuzzDay12(f *testing.F) { fuzzDay(f, 12) }
+func FuzzDay13(f *testing.F) { fuzzDay(f, 13) }
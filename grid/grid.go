@@ -0,0 +1,175 @@
+package grid
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"adventofcode23/input"
+)
+
+// Grid is a rectangular grid of bytes stored row by row in one slice.
+type Grid struct {
+	w, h  int
+	cells []byte
+}
+
+// New returns a w×h grid with every cell set to fill.
+func New(w, h int, fill byte) *Grid {
+	cells := make([]byte, w*h)
+	for i := range cells {
+		cells[i] = fill
+	}
+	return &Grid{w: w, h: h, cells: cells}
+}
+
+// FromLines builds a grid from equally long lines. A ragged line is reported
+// as an input.ParseError.
+func FromLines(lines []string) (*Grid, error) {
+	if len(lines) == 0 {
+		return &Grid{}, nil
+	}
+	g := &Grid{w: len(lines[0]), h: len(lines), cells: make([]byte, 0, len(lines[0])*len(lines))}
+	for i, line := range lines {
+		if len(line) != g.w {
+			return nil, input.NewSpan(i+1, line).Errorf("a row of %d cells like the first", g.w)
+		}
+		g.cells = append(g.cells, line...)
+	}
+	return g, nil
+}
+
+// Read reads a grid from r, one row per line.
+func Read(r io.Reader) (*Grid, error) {
+	lines, err := input.Lines(r)
+	if err != nil {
+		return nil, err
+	}
+	return FromLines(lines)
+}
+
+// Width returns the number of columns.
+func (g *Grid) Width() int { return g.w }
+
+// Height returns the number of rows.
+func (g *Grid) Height() int { return g.h }
+
+// In reports whether p lies inside the grid.
+func (g *Grid) In(p Point) bool {
+	return p.X >= 0 && p.X < g.w && p.Y >= 0 && p.Y < g.h
+}
+
+// Get returns the cell at p, and false if p is outside the grid.
+func (g *Grid) Get(p Point) (byte, bool) {
+	if !g.In(p) {
+		return 0, false
+	}
+	return g.cells[p.Y*g.w+p.X], true
+}
+
+// At returns the cell at p, or 0 if p is outside the grid.
+func (g *Grid) At(p Point) byte {
+	b, _ := g.Get(p)
+	return b
+}
+
+// Set stores b at p. It panics if p is outside the grid.
+func (g *Grid) Set(p Point, b byte) {
+	if !g.In(p) {
+		panic(fmt.Sprintf("grid: Set(%v) outside %dx%d grid", p, g.w, g.h))
+	}
+	g.cells[p.Y*g.w+p.X] = b
+}
+
+// Row returns row y. The slice shares the grid's storage.
+func (g *Grid) Row(y int) []byte {
+	return g.cells[y*g.w : (y+1)*g.w : (y+1)*g.w]
+}
+
+// Col returns a copy of column x.
+func (g *Grid) Col(x int) []byte {
+	col := make([]byte, g.h)
+	for y := range col {
+		col[y] = g.cells[y*g.w+x]
+	}
+	return col
+}
+
+// Clone returns a copy of g.
+func (g *Grid) Clone() *Grid {
+	return &Grid{w: g.w, h: g.h, cells: append([]byte(nil), g.cells...)}
+}
+
+// Transpose returns g mirrored along its main diagonal: rows become columns.
+func (g *Grid) Transpose() *Grid {
+	t := &Grid{w: g.h, h: g.w, cells: make([]byte, len(g.cells))}
+	for y := 0; y < g.h; y++ {
+		for x := 0; x < g.w; x++ {
+			t.cells[x*t.w+y] = g.cells[y*g.w+x]
+		}
+	}
+	return t
+}
+
+// FlipH returns g mirrored left to right.
+func (g *Grid) FlipH() *Grid {
+	f := g.Clone()
+	for y := 0; y < f.h; y++ {
+		row := f.Row(y)
+		for i, j := 0, len(row)-1; i < j; i, j = i+1, j-1 {
+			row[i], row[j] = row[j], row[i]
+		}
+	}
+	return f
+}
+
+// FlipV returns g mirrored top to bottom.
+func (g *Grid) FlipV() *Grid {
+	f := &Grid{w: g.w, h: g.h, cells: make([]byte, len(g.cells))}
+	for y := 0; y < g.h; y++ {
+		copy(f.Row(g.h-1-y), g.Row(y))
+	}
+	return f
+}
+
+// RotateCW returns g turned a quarter turn clockwise.
+func (g *Grid) RotateCW() *Grid {
+	return g.Transpose().FlipH()
+}
+
+// RotateCCW returns g turned a quarter turn counterclockwise.
+func (g *Grid) RotateCCW() *Grid {
+	return g.Transpose().FlipV()
+}
+
+// Find returns the first cell holding b, scanning row by row.
+func (g *Grid) Find(b byte) (Point, bool) {
+	for i, c := range g.cells {
+		if c == b {
+			return Point{i % g.w, i / g.w}, true
+		}
+	}
+	return Point{}, false
+}
+
+// FindAll returns every cell holding b, row by row.
+func (g *Grid) FindAll(b byte) []Point {
+	var points []Point
+	for i, c := range g.cells {
+		if c == b {
+			points = append(points, Point{i % g.w, i / g.w})
+		}
+	}
+	return points
+}
+
+// String returns the grid as newline-terminated rows.
+func (g *Grid) String() string {
+	var b strings.Builder
+	b.Grow(len(g.cells) + g.h)
+	for y := 0; y < g.h; y++ {
+		b.Write(g.Row(y))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
@@ -0,0 +1,136 @@
+package grid
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"adventofcode23/input"
+)
+
+func mustGrid(t *testing.T, lines ...string) *Grid {
+	t.Helper()
+	g, err := FromLines(lines)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return g
+}
+
+func TestAccess(t *testing.T) {
+	g := mustGrid(t, "abc", "def")
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Fatalf("size = %dx%d, want 3x2", g.Width(), g.Height())
+	}
+	if b := g.At(Point{2, 1}); b != 'f' {
+		t.Errorf("At(2,1) = %q, want 'f'", b)
+	}
+	for _, p := range []Point{{-1, 0}, {3, 0}, {0, 2}, {0, -1}} {
+		if b, ok := g.Get(p); ok || b != 0 {
+			t.Errorf("Get(%v) = %q, %v, want 0, false", p, b, ok)
+		}
+	}
+	if got := string(g.Col(1)); got != "be" {
+		t.Errorf("Col(1) = %q, want \"be\"", got)
+	}
+
+	// Rows are views into the grid.
+	g.Row(0)[0] = 'x'
+	if b := g.At(Point{0, 0}); b != 'x' {
+		t.Errorf("writing through Row did not change the grid: At(0,0) = %q", b)
+	}
+}
+
+func TestFromLinesRagged(t *testing.T) {
+	_, err := FromLines([]string{"abc", "de"})
+	var pe *input.ParseError
+	if !errors.As(err, &pe) || pe.Line != 2 {
+		t.Errorf("FromLines of a ragged grid = %v, want a ParseError on line 2", err)
+	}
+}
+
+func TestTransforms(t *testing.T) {
+	g := mustGrid(t, "abc", "def")
+	tests := []struct {
+		name string
+		got  *Grid
+		want string
+	}{
+		{"Transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"FlipH", g.FlipH(), "cba\nfed\n"},
+		{"FlipV", g.FlipV(), "def\nabc\n"},
+		{"RotateCW", g.RotateCW(), "da\neb\nfc\n"},
+		{"RotateCCW", g.RotateCCW(), "cf\nbe\nad\n"},
+	}
+	for _, tt := range tests {
+		if got := tt.got.String(); got != tt.want {
+			t.Errorf("%s:\n%s\nwant:\n%s", tt.name, got, tt.want)
+		}
+	}
+	if got := g.String(); got != "abc\ndef\n" {
+		t.Errorf("transforms modified the original grid:\n%s", got)
+	}
+}
+
+func TestNeighbors(t *testing.T) {
+	p := Point{1, 1}
+	want4 := [4]Point{{1, 0}, {2, 1}, {1, 2}, {0, 1}}
+	if got := p.Neighbors4(); got != want4 {
+		t.Errorf("Neighbors4 = %v, want %v", got, want4)
+	}
+	want8 := [8]Point{{1, 0}, {2, 0}, {2, 1}, {2, 2}, {1, 2}, {0, 2}, {0, 1}, {0, 0}}
+	if got := p.Neighbors8(); got != want8 {
+		t.Errorf("Neighbors8 = %v, want %v", got, want8)
+	}
+}
+
+func TestFindAll(t *testing.T) {
+	g := mustGrid(t, "#..", "..#", "#..")
+	want := []Point{{0, 0}, {2, 1}, {0, 2}}
+	if got := g.FindAll('#'); !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll = %v, want %v", got, want)
+	}
+}
+
+func TestBFS(t *testing.T) {
+	g := mustGrid(t,
+		"..#.",
+		".##.",
+		"....",
+	)
+	open := func(_, to Point, _ int) bool { return g.At(to) == '.' }
+	d := g.BFS(Point{0, 0}, open)
+	tests := []struct {
+		p    Point
+		want int
+	}{
+		{Point{0, 0}, 0},
+		{Point{1, 0}, 1},
+		{Point{3, 0}, 7},
+		{Point{2, 0}, -1},
+		{Point{9, 9}, -1},
+	}
+	for _, tt := range tests {
+		if got := d.At(tt.p); got != tt.want {
+			t.Errorf("distance to %v = %d, want %d", tt.p, got, tt.want)
+		}
+	}
+	if p, n := d.Max(); p != (Point{3, 0}) || n != 7 {
+		t.Errorf("Max = %v, %d, want (3,0), 7", p, n)
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+	g := mustGrid(t,
+		"aab",
+		"bab",
+		"bbb",
+	)
+	want := []Point{{0, 0}, {1, 0}, {1, 1}}
+	if got := g.FloodFill(Point{0, 0}, nil); !reflect.DeepEqual(got, want) {
+		t.Errorf("FloodFill = %v, want %v", got, want)
+	}
+	if got := g.FloodFill(Point{2, 0}, nil); len(got) != 6 {
+		t.Errorf("FloodFill from (2,0) reached %d cells, want 6", len(got))
+	}
+}
@@ -0,0 +1,64 @@
+// Package grid provides a compact 2D grid of bytes and the point, neighbour
+// and search helpers that grid puzzles keep needing.
+package grid
+
+// Point is a position in a grid: X grows to the right and Y grows downwards.
+type Point struct {
+	X, Y int
+}
+
+// Directions, in the N, E, S, W order used by Dirs4.
+var (
+	North = Point{0, -1}
+	East  = Point{1, 0}
+	South = Point{0, 1}
+	West  = Point{-1, 0}
+)
+
+// Dirs4 holds the four orthogonal directions, clockwise from North.
+var Dirs4 = [4]Point{North, East, South, West}
+
+// Dirs8 holds all eight directions, clockwise from North.
+var Dirs8 = [8]Point{
+	North, {1, -1}, East, {1, 1}, South, {-1, 1}, West, {-1, -1},
+}
+
+// Add returns p moved by d.
+func (p Point) Add(d Point) Point {
+	return Point{p.X + d.X, p.Y + d.Y}
+}
+
+// Sub returns the offset from q to p.
+func (p Point) Sub(q Point) Point {
+	return Point{p.X - q.X, p.Y - q.Y}
+}
+
+// Neighbors4 returns the orthogonal neighbours of p, in Dirs4 order.
+func (p Point) Neighbors4() [4]Point {
+	var n [4]Point
+	for i, d := range Dirs4 {
+		n[i] = p.Add(d)
+	}
+	return n
+}
+
+// Neighbors8 returns all eight neighbours of p, in Dirs8 order.
+func (p Point) Neighbors8() [8]Point {
+	var n [8]Point
+	for i, d := range Dirs8 {
+		n[i] = p.Add(d)
+	}
+	return n
+}
+
+// Manhattan returns the taxicab distance between p and q.
+func (p Point) Manhattan(q Point) int {
+	return abs(p.X-q.X) + abs(p.Y-q.Y)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,91 @@
+package grid
+
+// Distances holds a step count for every cell of a grid; -1 marks cells
+// that were not reached.
+type Distances struct {
+	w, h int
+	d    []int
+}
+
+func newDistances(w, h int) *Distances {
+	d := make([]int, w*h)
+	for i := range d {
+		d[i] = -1
+	}
+	return &Distances{w: w, h: h, d: d}
+}
+
+// At returns the distance to p, or -1 if p was not reached or lies outside
+// the grid.
+func (d *Distances) At(p Point) int {
+	if p.X < 0 || p.X >= d.w || p.Y < 0 || p.Y >= d.h {
+		return -1
+	}
+	return d.d[p.Y*d.w+p.X]
+}
+
+// Max returns the farthest reached cell and its distance.
+func (d *Distances) Max() (Point, int) {
+	best, at := -1, Point{}
+	for i, v := range d.d {
+		if v > best {
+			best, at = v, Point{i % d.w, i / d.w}
+		}
+	}
+	return at, best
+}
+
+// StepFunc reports whether a search may step from one cell to its neighbour
+// to, which lies in direction Dirs4[dir].
+type StepFunc func(from, to Point, dir int) bool
+
+// BFS walks the grid breadth first from start, moving orthogonally between
+// cells that step allows, and returns the number of steps to each cell.
+// A nil step allows every move inside the grid.
+func (g *Grid) BFS(start Point, step StepFunc) *Distances {
+	dist := newDistances(g.w, g.h)
+	g.search(start, step, dist.d, nil)
+	return dist
+}
+
+// FloodFill returns every cell reachable from start through orthogonal moves
+// that step allows, nearest first. A nil step fills the connected cells that
+// hold the same byte as start.
+func (g *Grid) FloodFill(start Point, step StepFunc) []Point {
+	if step == nil {
+		b := g.At(start)
+		step = func(_, to Point, _ int) bool { return g.At(to) == b }
+	}
+	dist := newDistances(g.w, g.h)
+	var region []Point
+	g.search(start, step, dist.d, func(p Point) { region = append(region, p) })
+	return region
+}
+
+// search runs a breadth-first search from start, recording step counts in
+// dist (which must be all -1) and calling visit, if set, on each cell reached.
+func (g *Grid) search(start Point, step StepFunc, dist []int, visit func(Point)) {
+	if !g.In(start) {
+		return
+	}
+	dist[start.Y*g.w+start.X] = 0
+	queue := []Point{start}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		if visit != nil {
+			visit(p)
+		}
+		next := dist[p.Y*g.w+p.X] + 1
+		for dir, q := range p.Neighbors4() {
+			if !g.In(q) || dist[q.Y*g.w+q.X] >= 0 {
+				continue
+			}
+			if step != nil && !step(p, q, dir) {
+				continue
+			}
+			dist[q.Y*g.w+q.X] = next
+			queue = append(queue, q)
+		}
+	}
+}
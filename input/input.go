@@ -0,0 +1,87 @@
+// Package input opens and splits puzzle inputs, wherever they come from: a
+// file, standard input, or an example embedded in a day package.
+package input
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// Stdin is the source name that reads standard input.
+	Stdin = "-"
+	// Example is the source name that reads the day's embedded example.
+	Example = "example"
+)
+
+// MaxLineLength is the longest line a Scanner accepts. bufio's default of
+// 64KB is too small for some generated inputs.
+const MaxLineLength = 256 << 20
+
+var (
+	mu       sync.RWMutex
+	examples = make(map[int]string)
+)
+
+// RegisterExample makes data available as the "example" source for day. Day
+// packages call it from init with a //go:embed-ed file.
+func RegisterExample(day int, data string) {
+	mu.Lock()
+	defer mu.Unlock()
+	if _, dup := examples[day]; dup {
+		panic(fmt.Sprintf("input: example for day %d registered twice", day))
+	}
+	examples[day] = data
+}
+
+// ExampleFor returns the example registered for day.
+func ExampleFor(day int) (string, bool) {
+	mu.RLock()
+	defer mu.RUnlock()
+	data, ok := examples[day]
+	return data, ok
+}
+
+// Open opens the input for day named by source: Stdin, Example, or a file path.
+func Open(day int, source string) (io.ReadCloser, error) {
+	switch source {
+	case Stdin:
+		return io.NopCloser(os.Stdin), nil
+	case Example:
+		data, ok := ExampleFor(day)
+		if !ok {
+			return nil, fmt.Errorf("input: day %d has no embedded example", day)
+		}
+		return io.NopCloser(strings.NewReader(data)), nil
+	}
+	return os.Open(source)
+}
+
+// NewScanner returns a line scanner for r that strips the '\r' of CRLF line
+// endings and accepts lines up to MaxLineLength bytes.
+func NewScanner(r io.Reader) *bufio.Scanner {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), MaxLineLength)
+	return scanner
+}
+
+// Lines reads every line of r, without line endings and without the blank
+// lines that often trail a saved input.
+func Lines(r io.Reader) ([]string, error) {
+	var lines []string
+	scanner := NewScanner(r)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines, nil
+}
@@ -0,0 +1,76 @@
+package input
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"a\nb\n", []string{"a", "b"}},
+		{"a\r\nb\r\n", []string{"a", "b"}},
+		{"a\n\nb", []string{"a", "", "b"}},
+		{"a\nb\n\n\n", []string{"a", "b"}},
+		{"a\r\n\r\n  \r\n", []string{"a"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		got, err := Lines(strings.NewReader(tt.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Lines(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLongLine(t *testing.T) {
+	long := strings.Repeat("x", 1<<20)
+	got, err := Lines(strings.NewReader(long + "\nshort\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0] != long || got[1] != "short" {
+		t.Errorf("Lines of a 1MB line returned %d lines", len(got))
+	}
+}
+
+func TestOpenExample(t *testing.T) {
+	RegisterExample(25, "snowverload\n")
+	rc, err := Open(25, Example)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rc.Close()
+	data, _ := io.ReadAll(rc)
+	if string(data) != "snowverload\n" {
+		t.Errorf("example = %q", data)
+	}
+	if _, err := Open(24, Example); err == nil {
+		t.Error("Open of a missing example succeeded")
+	}
+}
+
+func TestSpanPositions(t *testing.T) {
+	line := NewSpan(3, "Game 12: 3 blue, 4x red")
+	_, sets, _ := line.Cut(": ")
+	cubes := sets.Split(", ")
+	count, _, _ := cubes[1].Cut(" ")
+	_, err := count.Int()
+
+	want := `day2.txt:3:18: found "4x", want an integer`
+	if got := WithFile(err, "day2.txt").Error(); got != want {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+
+	fields := NewSpan(1, "  12  345 ").Fields()
+	if len(fields) != 2 || fields[1].Text != "345" || fields[1].Col != 7 {
+		t.Errorf("Fields = %+v", fields)
+	}
+}
@@ -0,0 +1,191 @@
+package input
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ParseError reports malformed puzzle input: where it is, what was found
+// there and what the parser expected instead.
+type ParseError struct {
+	File   string // input name; empty until the caller that opened the input fills it in
+	Line   int    // 1-based line number
+	Column int    // 1-based byte offset in the line; 0 when the whole line is at fault
+	Text   string // the offending text
+	Want   string // what the parser expected, e.g. "an integer"
+	Err    error  // underlying error, if any
+}
+
+func (e *ParseError) Error() string {
+	var b strings.Builder
+	if e.File != "" {
+		fmt.Fprintf(&b, "%s:%d", e.File, e.Line)
+		if e.Column > 0 {
+			fmt.Fprintf(&b, ":%d", e.Column)
+		}
+	} else {
+		fmt.Fprintf(&b, "line %d", e.Line)
+		if e.Column > 0 {
+			fmt.Fprintf(&b, ", column %d", e.Column)
+		}
+	}
+	if e.Text == "" {
+		fmt.Fprintf(&b, ": found nothing, want %s", e.Want)
+	} else {
+		fmt.Fprintf(&b, ": found %q, want %s", e.Text, e.Want)
+	}
+	if e.Err != nil {
+		fmt.Fprintf(&b, " (%v)", e.Err)
+	}
+	return b.String()
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// WithFile sets the file name of any ParseError in err's chain and returns err.
+func WithFile(err error, file string) error {
+	var pe *ParseError
+	if errors.As(err, &pe) && pe.File == "" {
+		pe.File = file
+	}
+	return err
+}
+
+// Span is a piece of an input line that remembers where it came from, so
+// parsers can split a line apart and still report exact positions.
+type Span struct {
+	Text string
+	Line int // 1-based
+	Col  int // 1-based column of Text's first byte
+}
+
+// NewSpan returns a Span covering line number n.
+func NewSpan(n int, line string) Span {
+	return Span{Text: line, Line: n, Col: 1}
+}
+
+// Errorf returns a ParseError for s saying what was expected instead.
+func (s Span) Errorf(format string, args ...any) error {
+	return &ParseError{Line: s.Line, Column: s.Col, Text: s.Text, Want: fmt.Sprintf(format, args...)}
+}
+
+// Sub returns s.Text[i:j] as a Span.
+func (s Span) Sub(i, j int) Span {
+	return Span{Text: s.Text[i:j], Line: s.Line, Col: s.Col + i}
+}
+
+// End returns the empty Span just past the end of s, for reporting missing text.
+func (s Span) End() Span {
+	return s.Sub(len(s.Text), len(s.Text))
+}
+
+// TrimSpace removes leading and trailing white space.
+func (s Span) TrimSpace() Span {
+	start := len(s.Text) - len(strings.TrimLeftFunc(s.Text, unicode.IsSpace))
+	end := len(strings.TrimRightFunc(s.Text, unicode.IsSpace))
+	if end < start {
+		end = start
+	}
+	return s.Sub(start, end)
+}
+
+// Cut slices s around the first sep, like strings.Cut.
+func (s Span) Cut(sep string) (before, after Span, found bool) {
+	i := strings.Index(s.Text, sep)
+	if i < 0 {
+		return s, s.End(), false
+	}
+	return s.Sub(0, i), s.Sub(i+len(sep), len(s.Text)), true
+}
+
+// CutPrefix returns s without prefix, like strings.CutPrefix.
+func (s Span) CutPrefix(prefix string) (Span, bool) {
+	if !strings.HasPrefix(s.Text, prefix) {
+		return s, false
+	}
+	return s.Sub(len(prefix), len(s.Text)), true
+}
+
+// Split slices s into all substrings separated by sep, like strings.Split.
+func (s Span) Split(sep string) []Span {
+	var spans []Span
+	rest := s
+	for {
+		before, after, found := rest.Cut(sep)
+		spans = append(spans, before)
+		if !found {
+			return spans
+		}
+		rest = after
+	}
+}
+
+// Fields splits s around runs of white space, like strings.Fields.
+func (s Span) Fields() []Span {
+	var spans []Span
+	start := -1
+	for i, r := range s.Text {
+		if unicode.IsSpace(r) {
+			if start >= 0 {
+				spans = append(spans, s.Sub(start, i))
+				start = -1
+			}
+		} else if start < 0 {
+			start = i
+		}
+	}
+	if start >= 0 {
+		spans = append(spans, s.Sub(start, len(s.Text)))
+	}
+	return spans
+}
+
+// Int parses s as a decimal integer.
+func (s Span) Int() (int, error) {
+	n, err := strconv.Atoi(s.Text)
+	if err != nil {
+		pe := s.Errorf("an integer").(*ParseError)
+		if errors.Is(err, strconv.ErrRange) {
+			pe.Err = strconv.ErrRange
+		}
+		return 0, pe
+	}
+	return n, nil
+}
+
+// Ints parses each field of s as a decimal integer.
+func (s Span) Ints() ([]int, error) {
+	var numbers []int
+	for _, f := range s.Fields() {
+		n, err := f.Int()
+		if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, n)
+	}
+	return numbers, nil
+}
+
+// CheckChars returns a ParseError for the first byte of s that is not in allowed.
+func (s Span) CheckChars(allowed string) error {
+	for i := 0; i < len(s.Text); i++ {
+		if strings.IndexByte(allowed, s.Text[i]) < 0 {
+			return s.Sub(i, i+1).Errorf("one of %q", allowed)
+		}
+	}
+	return nil
+}
+
+// Spans numbers the lines of an input, returning one Span per line.
+func Spans(lines []string) []Span {
+	spans := make([]Span, len(lines))
+	for i, line := range lines {
+		spans[i] = NewSpan(i+1, line)
+	}
+	return spans
+}
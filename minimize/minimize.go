@@ -0,0 +1,145 @@
+// Package minimize shrinks a failing puzzle input to a small one that still
+// fails, by delta debugging: it keeps removing lines, or blank-line separated
+// blocks, for as long as a predicate still holds.
+package minimize
+
+import (
+	"bytes"
+	"context"
+	"errors"
+)
+
+// ErrDoesNotHold is returned by Minimize when the input it is given does not
+// satisfy the predicate in the first place.
+var ErrDoesNotHold = errors.New("the predicate does not hold for the input")
+
+// Predicate reports whether an input still shows the behaviour being chased:
+// a panic, a particular error, two variants disagreeing and so on.
+type Predicate func(ctx context.Context, input []byte) bool
+
+// SplitFunc cuts an input into the units that may be removed. Joining the
+// units back together in order must give the input again.
+type SplitFunc func(input []byte) [][]byte
+
+// Lines splits an input into lines, each with its newline.
+func Lines(input []byte) [][]byte {
+	var units [][]byte
+	for len(input) > 0 {
+		i := bytes.IndexByte(input, '\n') + 1
+		if i == 0 {
+			i = len(input)
+		}
+		units = append(units, input[:i])
+		input = input[i:]
+	}
+	return units
+}
+
+// Blocks splits an input into blank-line separated blocks, such as the maps
+// of day 5 or the patterns of day 13. Each block keeps the blank lines that
+// follow it.
+func Blocks(input []byte) [][]byte {
+	var units [][]byte
+	lines := Lines(input)
+	start, pos := 0, 0
+	for i, line := range lines {
+		pos += len(line)
+		blank := len(bytes.TrimSpace(line)) == 0
+		nextBlank := i+1 < len(lines) && len(bytes.TrimSpace(lines[i+1])) == 0
+		if blank && !nextBlank {
+			units = append(units, input[start:pos])
+			start = pos
+		}
+	}
+	if start < len(input) {
+		units = append(units, input[start:])
+	}
+	return units
+}
+
+// Stats describes a minimization.
+type Stats struct {
+	// Tests is the number of times the predicate was called.
+	Tests int
+}
+
+// Minimize returns the smallest input it can find for which holds is true,
+// first removing whole blocks and then single lines. The input itself must
+// satisfy holds. A done context stops the search early with the best input
+// so far and the context's error.
+func Minimize(ctx context.Context, input []byte, holds Predicate) (smallest []byte, stats Stats, err error) {
+	test := func(candidate []byte) bool {
+		stats.Tests++
+		return holds(ctx, candidate)
+	}
+	if !test(input) {
+		return input, stats, ErrDoesNotHold
+	}
+	smallest = input
+	for _, split := range []SplitFunc{Blocks, Lines} {
+		if smallest, err = ddmin(ctx, smallest, split, test); err != nil {
+			return smallest, stats, err
+		}
+	}
+	return smallest, stats, nil
+}
+
+// ddmin is Zeller's delta debugging over the units of input: it tries each
+// of n chunks of units on its own, then each complement, and refines the
+// chunks when neither helps, until no single unit can be removed.
+func ddmin(ctx context.Context, input []byte, split SplitFunc, test func([]byte) bool) ([]byte, error) {
+	units := split(input)
+	n := 2
+	for len(units) >= 2 {
+		if err := ctx.Err(); err != nil {
+			return bytes.Join(units, nil), err
+		}
+		chunks := chunk(units, n)
+		reduced := false
+		for i := range chunks {
+			if candidate := bytes.Join(chunks[i], nil); test(candidate) {
+				units, n, reduced = chunks[i], 2, true
+				break
+			}
+		}
+		if !reduced && n > 2 {
+			for i := range chunks {
+				rest := complement(chunks, i)
+				if test(bytes.Join(rest, nil)) {
+					units, n, reduced = rest, max(n-1, 2), true
+					break
+				}
+			}
+		}
+		if !reduced {
+			if n >= len(units) {
+				break
+			}
+			n = min(2*n, len(units))
+		}
+	}
+	return bytes.Join(units, nil), nil
+}
+
+// chunk splits units into n runs of nearly equal length.
+func chunk(units [][]byte, n int) [][][]byte {
+	chunks := make([][][]byte, 0, n)
+	for i := 0; i < n; i++ {
+		lo, hi := i*len(units)/n, (i+1)*len(units)/n
+		if lo < hi {
+			chunks = append(chunks, units[lo:hi])
+		}
+	}
+	return chunks
+}
+
+// complement joins every chunk except the i'th.
+func complement(chunks [][][]byte, i int) [][]byte {
+	var rest [][]byte
+	for j, c := range chunks {
+		if j != i {
+			rest = append(rest, c...)
+		}
+	}
+	return rest
+}
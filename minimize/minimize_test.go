@@ -0,0 +1,53 @@
+package minimize
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSplit(t *testing.T) {
+	in := []byte("seeds: 1\n\na:\n1 2 3\n\n\nb:\n4 5 6")
+	for name, split := range map[string]SplitFunc{"Lines": Lines, "Blocks": Blocks} {
+		if got := bytes.Join(split(in), nil); !bytes.Equal(got, in) {
+			t.Errorf("%s units join to %q, want %q", name, got, in)
+		}
+	}
+	if got := len(Lines(in)); got != 8 {
+		t.Errorf("Lines gave %d units, want 8", got)
+	}
+	if got := len(Blocks(in)); got != 3 {
+		t.Errorf("Blocks gave %d units, want 3", got)
+	}
+}
+
+// TestMinimize finds the two lines that together make a fake solver fail.
+func TestMinimize(t *testing.T) {
+	var lines []string
+	for i := 0; i < 50; i++ {
+		lines = append(lines, strings.Repeat("x", i))
+	}
+	lines[17] = "bad"
+	lines[41] = "worse"
+	in := []byte(strings.Join(lines, "\n") + "\n")
+	holds := func(ctx context.Context, input []byte) bool {
+		return bytes.Contains(input, []byte("bad\n")) && bytes.Contains(input, []byte("worse\n"))
+	}
+
+	got, stats, err := Minimize(context.Background(), in, holds)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "bad\nworse\n"; string(got) != want {
+		t.Errorf("Minimize = %q, want %q", got, want)
+	}
+	if stats.Tests == 0 || stats.Tests > 200 {
+		t.Errorf("Minimize ran the predicate %d times", stats.Tests)
+	}
+
+	if _, _, err := Minimize(context.Background(), []byte("fine\n"), holds); !errors.Is(err, ErrDoesNotHold) {
+		t.Errorf("Minimize of an input the predicate rejects: %v, want ErrDoesNotHold", err)
+	}
+}
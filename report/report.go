@@ -0,0 +1,130 @@
+// Package report writes solver results as records in a format chosen on the
+// command line: plain answers for people, or JSON lines and CSV for tools.
+package report
+
+import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"adventofcode23/aoc"
+)
+
+// Record is the result of one solver run.
+type Record struct {
+	Day     int        `json:"day"`
+	Part    int        `json:"part"`
+	Variant string     `json:"variant"`
+	Answer  aoc.Answer `json:"answer"`
+	// Duration is the solver's run time; JSON and CSV give it in nanoseconds.
+	Duration time.Duration `json:"duration"`
+	// Input is the input's name as given on the command line and
+	// InputSHA256 the hex SHA-256 of its contents, so that results for
+	// different inputs are never mixed up.
+	Input       string `json:"input"`
+	InputSHA256 string `json:"input_sha256"`
+	// Error is set, and Answer is zero, when the solver failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Hash returns the hex SHA-256 of an input, as used in Record.InputSHA256.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Format selects how records are written. It implements flag.Value.
+type Format string
+
+const (
+	// Text writes just the answer, or the error, one per line.
+	Text Format = "text"
+	// JSON writes one JSON object per line.
+	JSON Format = "json"
+	// CSV writes a header row and then one row per record.
+	CSV Format = "csv"
+)
+
+func (f *Format) String() string {
+	return string(*f)
+}
+
+// Set parses a format name.
+func (f *Format) Set(s string) error {
+	switch Format(s) {
+	case Text, JSON, CSV:
+		*f = Format(s)
+		return nil
+	}
+	return fmt.Errorf("unknown format %q, want text, json or csv", s)
+}
+
+// csvHeader names the CSV columns, which follow the JSON field names.
+var csvHeader = []string{"day", "part", "variant", "answer", "duration", "input", "input_sha256", "error"}
+
+// Writer writes records in one format.
+type Writer struct {
+	format Format
+	w      io.Writer
+	enc    *json.Encoder
+	csv    *csv.Writer
+	header bool // whether the CSV header has been written
+}
+
+// NewWriter returns a Writer writing f to w. Call Flush when done.
+func NewWriter(w io.Writer, f Format) *Writer {
+	rw := &Writer{format: f, w: w}
+	switch f {
+	case JSON:
+		rw.enc = json.NewEncoder(w)
+	case CSV:
+		rw.csv = csv.NewWriter(w)
+	}
+	return rw
+}
+
+// Write writes one record.
+func (w *Writer) Write(r Record) error {
+	switch w.format {
+	case JSON:
+		return w.enc.Encode(r)
+	case CSV:
+		if !w.header {
+			w.header = true
+			if err := w.csv.Write(csvHeader); err != nil {
+				return err
+			}
+		}
+		return w.csv.Write([]string{
+			strconv.Itoa(r.Day),
+			strconv.Itoa(r.Part),
+			r.Variant,
+			r.Answer.String(),
+			strconv.FormatInt(int64(r.Duration), 10),
+			r.Input,
+			r.InputSHA256,
+			r.Error,
+		})
+	default:
+		if r.Error != "" {
+			_, err := fmt.Fprintln(w.w, "error:", r.Error)
+			return err
+		}
+		_, err := fmt.Fprintln(w.w, r.Answer)
+		return err
+	}
+}
+
+// Flush writes any buffered data.
+func (w *Writer) Flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		return w.csv.Error()
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package report
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"adventofcode23/aoc"
+)
+
+func TestWriter(t *testing.T) {
+	rec := Record{
+		Day: 6, Part: 2, Variant: "default",
+		Answer:      aoc.Int(71503),
+		Duration:    1500 * time.Microsecond,
+		Input:       "example",
+		InputSHA256: Hash([]byte("x")),
+	}
+	tests := []struct {
+		format Format
+		want   string
+	}{
+		{Text, "71503\n71503\n"},
+		{JSON, `{"day":6,"part":2,"variant":"default","answer":71503,"duration":1500000,"input":"example","input_sha256":"2d711642b726b04401627ca9fbac32f5c8530fb1903cc4db02258717921a4881"}` + "\n" +
+			`{"day":6,"part":2,"variant":"default","answer":71503,"duration":1500000,"input":"example","input_sha256":"2d711642b726b04401627ca9fbac32f5c8530fb1903cc4db02258717921a4881"}` + "\n"},
+		{CSV, "day,part,variant,answer,duration,input,input_sha256,error\n" +
+			"6,2,default,71503,1500000,example,2d711642b726b04401627ca9fbac32f5c8530fb1903cc4db02258717921a4881,\n" +
+			"6,2,default,71503,1500000,example,2d711642b726b04401627ca9fbac32f5c8530fb1903cc4db02258717921a4881,\n"},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		w := NewWriter(&buf, tt.format)
+		for i := 0; i < 2; i++ {
+			if err := w.Write(rec); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := w.Flush(); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("%s output:\n%s\nwant:\n%s", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestFormatSet(t *testing.T) {
+	var f Format
+	if err := f.Set("csv"); err != nil || f != CSV {
+		t.Errorf("Set(csv) = %v, format %q", err, f)
+	}
+	if err := f.Set("yaml"); err == nil {
+		t.Error("Set(yaml) succeeded")
+	}
+}
@@ -0,0 +1,164 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMinInterval is the pause kept between two requests to the site.
+const DefaultMinInterval = 5 * time.Second
+
+// userAgent identifies the tool to the site operators, as they ask automated
+// clients to do.
+const userAgent = "github.com/quake0day/adventofcode23 (aoc command)"
+
+// ErrNoSession is returned when a download is needed but no session cookie is configured.
+var ErrNoSession = errors.New("site: no session cookie configured (set AOC_SESSION or add it to the config file)")
+
+// Client downloads puzzle inputs. Inputs are cached on disk and never
+// downloaded twice, and requests are spaced at least MinInterval apart.
+type Client struct {
+	cfg         Config
+	httpClient  *http.Client
+	MinInterval time.Duration
+
+	mu   sync.Mutex
+	last time.Time // when the last request was sent
+	now  func() time.Time
+	wait func(ctx context.Context, d time.Duration) error
+}
+
+// NewClient returns a Client for cfg.
+func NewClient(cfg Config) *Client {
+	if cfg.Year == 0 {
+		cfg.Year = DefaultYear
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	return &Client{
+		cfg:         cfg,
+		httpClient:  &http.Client{Timeout: 30 * time.Second},
+		MinInterval: DefaultMinInterval,
+		now:         time.Now,
+		wait:        sleep,
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// InputPath returns the path of the cached input for day, downloading it first
+// if it is not in the cache yet.
+func (c *Client) InputPath(ctx context.Context, day int) (string, error) {
+	if day < 1 || day > 25 {
+		return "", fmt.Errorf("site: invalid day %d", day)
+	}
+	if c.cfg.CacheDir == "" {
+		return "", errors.New("site: no cache directory configured")
+	}
+	path := filepath.Join(c.cfg.CacheDir, fmt.Sprint(c.cfg.Year), fmt.Sprintf("day%d.txt", day))
+	if _, err := os.Stat(path); err == nil {
+		return path, nil
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	data, err := c.download(ctx, fmt.Sprintf("/%d/day/%d/input", c.cfg.Year, day))
+	if err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(path, data); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// Input returns the input for day, from the cache when possible.
+func (c *Client) Input(ctx context.Context, day int) ([]byte, error) {
+	path, err := c.InputPath(ctx, day)
+	if err != nil {
+		return nil, err
+	}
+	return os.ReadFile(path)
+}
+
+// do sends req once the rate limit allows it.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	if c.cfg.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.last.IsZero() {
+		if d := c.last.Add(c.MinInterval).Sub(c.now()); d > 0 {
+			if err := c.wait(ctx, d); err != nil {
+				return nil, err
+			}
+		}
+	}
+	c.last = c.now()
+
+	req.Header.Set("User-Agent", userAgent)
+	req.AddCookie(&http.Cookie{Name: "session", Value: c.cfg.Session})
+	return c.httpClient.Do(req.WithContext(ctx))
+}
+
+func (c *Client) download(ctx context.Context, path string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, strings.TrimSuffix(c.cfg.BaseURL, "/")+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("site: GET %s: %s: %s", path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return body, nil
+}
+
+// writeFileAtomic writes data to path through a temporary file so that an
+// interrupted download never leaves a truncated input in the cache.
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".download-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,91 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"adventofcode23/site/sitetest"
+)
+
+func newTestClient(t *testing.T, srv *sitetest.Server) *Client {
+	t.Helper()
+	c := NewClient(Config{
+		Session:  sitetest.Session,
+		BaseURL:  srv.URL,
+		CacheDir: t.TempDir(),
+	})
+	c.wait = func(context.Context, time.Duration) error { return nil }
+	return c
+}
+
+func TestInputIsCached(t *testing.T) {
+	srv := sitetest.NewServer()
+	defer srv.Close()
+	srv.SetInput(2023, 5, "seeds: 79 14 55 13\n")
+	c := newTestClient(t, srv)
+
+	for i := 0; i < 3; i++ {
+		data, err := c.Input(context.Background(), 5)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := string(data); got != "seeds: 79 14 55 13\n" {
+			t.Fatalf("Input = %q", got)
+		}
+	}
+	if n := srv.Requests(); n != 1 {
+		t.Errorf("server saw %d requests, want 1", n)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	srv := sitetest.NewServer()
+	defer srv.Close()
+	c := newTestClient(t, srv)
+
+	if _, err := c.Input(context.Background(), 6); err == nil {
+		t.Error("Input of a missing day succeeded")
+	}
+
+	c.cfg.Session = "wrong"
+	srv.SetInput(2023, 7, "32T3K 765\n")
+	if _, err := c.Input(context.Background(), 7); err == nil {
+		t.Error("Input with a bad session succeeded")
+	}
+
+	c.cfg.Session = ""
+	if _, err := c.Input(context.Background(), 7); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Input without a session: err = %v, want ErrNoSession", err)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	srv := sitetest.NewServer()
+	defer srv.Close()
+	for day := 1; day <= 3; day++ {
+		srv.SetInput(2023, day, "input\n")
+	}
+	c := newTestClient(t, srv)
+
+	clock := time.Date(2023, 12, 1, 5, 0, 0, 0, time.UTC)
+	var waits []time.Duration
+	c.now = func() time.Time { return clock }
+	c.wait = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		clock = clock.Add(d)
+		return nil
+	}
+
+	for day := 1; day <= 3; day++ {
+		if _, err := c.Input(context.Background(), day); err != nil {
+			t.Fatal(err)
+		}
+		clock = clock.Add(time.Second)
+	}
+	want := c.MinInterval - time.Second
+	if len(waits) != 2 || waits[0] != want || waits[1] != want {
+		t.Errorf("waits = %v, want two of %v", waits, want)
+	}
+}
@@ -0,0 +1,85 @@
+// Package site talks to the Advent of Code website: it downloads puzzle
+// inputs into a per-user cache and submits answers.
+package site
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	// DefaultBaseURL is the Advent of Code website.
+	DefaultBaseURL = "https://adventofcode.com"
+	// DefaultYear is the event this repository solves.
+	DefaultYear = 2023
+)
+
+// Config holds the settings needed to talk to the site.
+type Config struct {
+	// Session is the value of the "session" cookie of a logged-in browser.
+	Session string `json:"session"`
+	Year    int    `json:"year"`
+	BaseURL string `json:"base_url"`
+	// CacheDir is where downloaded inputs are kept.
+	CacheDir string `json:"cache_dir"`
+}
+
+// ConfigPath returns the location of the user's config file,
+// <user config dir>/adventofcode23/config.json.
+func ConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "adventofcode23", "config.json"), nil
+}
+
+// LoadConfig reads the config file, if there is one, then applies the
+// AOC_SESSION, AOC_BASE_URL and AOC_CACHE_DIR environment variables on top of
+// it and fills in defaults for anything still unset.
+func LoadConfig() (Config, error) {
+	var cfg Config
+	path, err := ConfigPath()
+	if err != nil {
+		return cfg, err
+	}
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &cfg); err != nil {
+			return cfg, fmt.Errorf("%s: %w", path, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return cfg, err
+	}
+
+	if v := os.Getenv("AOC_SESSION"); v != "" {
+		cfg.Session = v
+	}
+	if v := os.Getenv("AOC_BASE_URL"); v != "" {
+		cfg.BaseURL = v
+	}
+	if v := os.Getenv("AOC_CACHE_DIR"); v != "" {
+		cfg.CacheDir = v
+	}
+	cfg.Session = strings.TrimSpace(cfg.Session)
+
+	if cfg.Year == 0 {
+		cfg.Year = DefaultYear
+	}
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	if cfg.CacheDir == "" {
+		dir, err := os.UserCacheDir()
+		if err != nil {
+			return cfg, err
+		}
+		cfg.CacheDir = filepath.Join(dir, "adventofcode23")
+	}
+	return cfg, nil
+}
@@ -0,0 +1,102 @@
+package site
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+)
+
+// Page is what ParsePage finds in a saved puzzle description.
+type Page struct {
+	Title string
+	// Parts holds one entry per part on the page; part 2 only appears once
+	// part 1 has been solved.
+	Parts []PagePart
+}
+
+// PagePart is the description of one part.
+type PagePart struct {
+	// Blocks are the texts of the <pre><code> blocks, in order.
+	Blocks []string
+	// Answers are the emphasized code spans, <code><em>...</em></code>,
+	// which is how the puzzle text highlights results. The example's
+	// answer is usually the last of them.
+	Answers []string
+}
+
+var (
+	descRE   = regexp.MustCompile(`(?s)<article[^>]*class="day-desc"[^>]*>(.*?)</article>`)
+	titleRE  = regexp.MustCompile(`<h2[^>]*>--- Day \d+: (.*?) ---</h2>`)
+	blockRE  = regexp.MustCompile(`(?s)<pre><code>(.*?)</code></pre>`)
+	answerRE = regexp.MustCompile(`(?s)<code><em>(.*?)</em></code>|<em><code>(.*?)</code></em>`)
+)
+
+// ParsePage reads a puzzle description saved from the site.
+func ParsePage(r io.Reader) (*Page, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	src := string(data)
+	articles := descRE.FindAllStringSubmatch(src, -1)
+	if len(articles) == 0 {
+		return nil, errors.New("site: no puzzle description (<article class=\"day-desc\">) in page")
+	}
+
+	page := &Page{}
+	if m := titleRE.FindStringSubmatch(src); m != nil {
+		page.Title = text(m[1])
+	}
+	for _, a := range articles {
+		var part PagePart
+		for _, m := range blockRE.FindAllStringSubmatch(a[1], -1) {
+			part.Blocks = append(part.Blocks, text(m[1]))
+		}
+		for _, m := range answerRE.FindAllStringSubmatch(a[1], -1) {
+			part.Answers = append(part.Answers, text(m[1]+m[2]))
+		}
+		page.Parts = append(page.Parts, part)
+	}
+	return page, nil
+}
+
+// Page downloads the puzzle description of day and parses it. It is not
+// cached, since the page gains part 2 once part 1 is solved.
+func (c *Client) Page(ctx context.Context, day int) (*Page, error) {
+	if day < 1 || day > 25 {
+		return nil, fmt.Errorf("site: invalid day %d", day)
+	}
+	data, err := c.download(ctx, fmt.Sprintf("/%d/day/%d", c.cfg.Year, day))
+	if err != nil {
+		return nil, err
+	}
+	return ParsePage(bytes.NewReader(data))
+}
+
+// Example returns the example input and answer for a part, counted from 1:
+// the part's first block, or the previous part's when it has none, as part
+// 2 usually reuses the example of part 1, and its last emphasized answer.
+func (p *Page) Example(part int) (input, answer string, ok bool) {
+	if part < 1 || part > len(p.Parts) {
+		return "", "", false
+	}
+	answers := p.Parts[part-1].Answers
+	if len(answers) == 0 {
+		return "", "", false
+	}
+	for i := part - 1; i >= 0; i-- {
+		if blocks := p.Parts[i].Blocks; len(blocks) > 0 {
+			return blocks[0], answers[len(answers)-1], true
+		}
+	}
+	return "", "", false
+}
+
+// text strips the tags from an HTML fragment and decodes its entities.
+func text(fragment string) string {
+	return html.UnescapeString(tagRE.ReplaceAllString(fragment, ""))
+}
@@ -0,0 +1,48 @@
+package site
+
+import (
+	"strings"
+	"testing"
+)
+
+const savedPage = `<!DOCTYPE html><html><body><main>
+<article class="day-desc"><h2>--- Day 6: Wait For It ---</h2>
+<p>For example:</p>
+<pre><code>Time:      7  15   30
+Distance:  9  40  200
+</code></pre>
+<p>The third race lasts <code>30</code> milliseconds; there are <code><em>9</em></code> ways.</p>
+<p>Multiply them together to get <code><em>288</em></code> (<code>4</code> * <code>8</code> * <code>9</code>).</p>
+</article>
+<p>Your puzzle answer was <code>1731600</code>.</p>
+<article class="day-desc"><h2 id="part2">--- Part Two ---</h2>
+<p>There's really only one race &amp; the spaces are bad kerning: <code>71530</code>.</p>
+<p>In this example, you can win in <code><em>71503</em></code> ways.</p>
+</article>
+</main></body></html>`
+
+func TestParsePage(t *testing.T) {
+	page, err := ParsePage(strings.NewReader(savedPage))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if page.Title != "Wait For It" || len(page.Parts) != 2 {
+		t.Fatalf("ParsePage = %q with %d parts, want \"Wait For It\" with 2", page.Title, len(page.Parts))
+	}
+
+	in, answer, ok := page.Example(1)
+	if want := "Time:      7  15   30\nDistance:  9  40  200\n"; !ok || in != want || answer != "288" {
+		t.Errorf("Example(1) = %q, %q, %v; want %q, 288", in, answer, ok, want)
+	}
+	// Part 2 has no block of its own, so it reuses part 1's example.
+	if in2, answer, ok := page.Example(2); !ok || in2 != in || answer != "71503" {
+		t.Errorf("Example(2) = %q, %q, %v; want part 1's input, 71503", in2, answer, ok)
+	}
+	if _, _, ok := page.Example(3); ok {
+		t.Error("Example(3) found an example on a two-part page")
+	}
+
+	if _, err := ParsePage(strings.NewReader("<html></html>")); err == nil {
+		t.Error("ParsePage accepted a page with no description")
+	}
+}
@@ -0,0 +1,188 @@
+// Package sitetest provides a stand-in for the Advent of Code website, for
+// exercising package site without network access.
+package sitetest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Session is the only session cookie the fake server accepts.
+const Session = "sitetest-session"
+
+// WrongAnswerWait is the cooldown the fake server imposes after a wrong answer.
+const WrongAnswerWait = time.Minute
+
+// Server is a fake Advent of Code site serving the puzzle pages at
+// /{year}/day/{n} and the inputs at /{year}/day/{n}/input, and accepting
+// answers posted to /{year}/day/{n}/answer.
+type Server struct {
+	*httptest.Server
+
+	// Now is the server's clock; it defaults to time.Now.
+	Now func() time.Time
+
+	mu            sync.Mutex
+	inputs        map[puzzle]string
+	pages         map[puzzle]string
+	answers       map[puzzle]string
+	solved        map[puzzle]bool
+	cooldownUntil time.Time
+	requests      int
+	submissions   int
+}
+
+type puzzle struct {
+	year, day, part int
+}
+
+// NewServer starts a fake site. Call Close when done.
+func NewServer() *Server {
+	s := &Server{
+		Now:     time.Now,
+		inputs:  make(map[puzzle]string),
+		pages:   make(map[puzzle]string),
+		answers: make(map[puzzle]string),
+		solved:  make(map[puzzle]bool),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+	return s
+}
+
+// SetInput makes the server answer the input request for year and day with input.
+func (s *Server) SetInput(year, day int, input string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.inputs[puzzle{year, day, 0}] = input
+}
+
+// SetPage makes the server answer the request for the puzzle description of
+// year and day with page.
+func (s *Server) SetPage(year, day int, page string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pages[puzzle{year, day, 0}] = page
+}
+
+// SetAnswer sets the right answer for one part of a puzzle.
+func (s *Server) SetAnswer(year, day, part int, answer string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.answers[puzzle{year, day, part}] = answer
+}
+
+// Requests returns how many requests the server has received.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// Submissions returns how many answers have been posted to the server.
+func (s *Server) Submissions() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.submissions
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	s.mu.Unlock()
+
+	if c, err := r.Cookie("session"); err != nil || c.Value != Session {
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+		return
+	}
+
+	var year, day int
+	if _, err := fmt.Sscanf(r.URL.Path, "/%d/day/%d", &year, &day); err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	action := strings.TrimPrefix(strings.TrimPrefix(r.URL.Path, fmt.Sprintf("/%d/day/%d", year, day)), "/")
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		s.servePage(w, r, year, day)
+	case action == "input" && r.Method == http.MethodGet:
+		s.serveInput(w, r, year, day)
+	case action == "answer" && r.Method == http.MethodPost:
+		s.serveAnswer(w, r, year, day)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *Server) servePage(w http.ResponseWriter, r *http.Request, year, day int) {
+	s.mu.Lock()
+	page, ok := s.pages[puzzle{year, day, 0}]
+	s.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	fmt.Fprint(w, page)
+}
+
+func (s *Server) serveInput(w http.ResponseWriter, r *http.Request, year, day int) {
+	s.mu.Lock()
+	input, ok := s.inputs[puzzle{year, day, 0}]
+	s.mu.Unlock()
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	fmt.Fprint(w, input)
+}
+
+// serveAnswer judges a posted answer and replies with a page worded like the
+// real site's.
+func (s *Server) serveAnswer(w http.ResponseWriter, r *http.Request, year, day int) {
+	part, _ := strconv.Atoi(r.PostFormValue("level"))
+	answer := r.PostFormValue("answer")
+	p := puzzle{year, day, part}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.submissions++
+	back := fmt.Sprintf(` [<a href="/%d/day/%d">Return to Day %d</a>]`, year, day, day)
+
+	right, ok := s.answers[p]
+	now := s.Now()
+	switch {
+	case !ok || s.solved[p]:
+		writeArticle(w, "You don't seem to be solving the right level.  Did you already complete it?"+back)
+	case now.Before(s.cooldownUntil):
+		left := s.cooldownUntil.Sub(now).Round(time.Second)
+		wait := fmt.Sprintf("%ds", int(left.Seconds()))
+		if left >= time.Minute {
+			wait = fmt.Sprintf("%dm %ds", int(left.Minutes()), int(left.Seconds())%60)
+		}
+		writeArticle(w, "You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have "+wait+" left to wait."+back)
+	case answer == right:
+		s.solved[p] = true
+		writeArticle(w, `That's the right answer!  You are <span class="day-success">one gold star</span> closer to restoring snow operations.`+back)
+	default:
+		s.cooldownUntil = now.Add(WrongAnswerWait)
+		hint := ""
+		got, err1 := strconv.ParseInt(answer, 10, 64)
+		want, err2 := strconv.ParseInt(right, 10, 64)
+		if err1 == nil && err2 == nil {
+			if got > want {
+				hint = "; your answer is too high"
+			} else {
+				hint = "; your answer is too low"
+			}
+		}
+		writeArticle(w, "That's not the right answer"+hint+".  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again."+back)
+	}
+}
+
+func writeArticle(w http.ResponseWriter, text string) {
+	fmt.Fprintf(w, "<!DOCTYPE html>\n<html lang=\"en-us\">\n<body>\n<main>\n<article><p>%s</p></article>\n</main>\n</body>\n</html>\n", text)
+}
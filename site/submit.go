@@ -0,0 +1,314 @@
+package site
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Verdict is the site's judgement of a submitted answer.
+type Verdict int
+
+const (
+	Unknown Verdict = iota
+	Correct
+	Wrong   // wrong, with no hint about its size
+	TooHigh // wrong, and greater than the right answer
+	TooLow  // wrong, and less than the right answer
+	Wait    // rejected unseen because the last answer was too recent
+	AlreadySolved
+)
+
+var verdictNames = [...]string{"unknown", "correct", "wrong", "too high", "too low", "wait", "already solved"}
+
+func (v Verdict) String() string {
+	if v < 0 || int(v) >= len(verdictNames) {
+		return fmt.Sprintf("Verdict(%d)", int(v))
+	}
+	return verdictNames[v]
+}
+
+// MarshalText encodes v by name so the submission history stays readable.
+func (v Verdict) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText decodes a name written by MarshalText.
+func (v *Verdict) UnmarshalText(text []byte) error {
+	for i, name := range verdictNames {
+		if name == string(text) {
+			*v = Verdict(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("site: unknown verdict %q", text)
+}
+
+// IsWrong reports whether v says the answer was judged and is not right.
+func (v Verdict) IsWrong() bool {
+	return v == Wrong || v == TooHigh || v == TooLow
+}
+
+// Outcome is a parsed answer response.
+type Outcome struct {
+	Verdict Verdict
+	// Wait is how long the site asks us to hold off before the next
+	// submission, for Wait and wrong verdicts.
+	Wait time.Duration
+	// Message is the text of the response with markup removed.
+	Message string
+}
+
+var (
+	articleRE  = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+	tagRE      = regexp.MustCompile(`<[^>]*>`)
+	spaceRE    = regexp.MustCompile(`\s+`)
+	leftRE     = regexp.MustCompile(`You have (?:(\d+)m )?(\d+)s left to wait`)
+	cooldownRE = regexp.MustCompile(`(?i)please wait (\w+) minutes? before trying again`)
+)
+
+var numberWords = map[string]int{
+	"one": 1, "two": 2, "three": 3, "four": 4, "five": 5,
+	"six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10,
+}
+
+// ParseOutcome reads the HTML page returned for an answer submission.
+func ParseOutcome(page string) Outcome {
+	text := page
+	if m := articleRE.FindStringSubmatch(page); m != nil {
+		text = m[1]
+	}
+	text = strings.TrimSpace(spaceRE.ReplaceAllString(html.UnescapeString(tagRE.ReplaceAllString(text, "")), " "))
+
+	o := Outcome{Message: text}
+	switch {
+	case strings.Contains(text, "That's the right answer"):
+		o.Verdict = Correct
+	case strings.Contains(text, "You gave an answer too recently"):
+		o.Verdict = Wait
+	case strings.Contains(text, "You don't seem to be solving the right level"):
+		o.Verdict = AlreadySolved
+	case strings.Contains(text, "That's not the right answer"):
+		switch {
+		case strings.Contains(text, "too high"):
+			o.Verdict = TooHigh
+		case strings.Contains(text, "too low"):
+			o.Verdict = TooLow
+		default:
+			o.Verdict = Wrong
+		}
+	}
+
+	if m := leftRE.FindStringSubmatch(text); m != nil {
+		minutes, _ := strconv.Atoi(m[1])
+		seconds, _ := strconv.Atoi(m[2])
+		o.Wait = time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+	} else if m := cooldownRE.FindStringSubmatch(text); m != nil {
+		n, ok := numberWords[strings.ToLower(m[1])]
+		if !ok {
+			n, _ = strconv.Atoi(m[1])
+		}
+		o.Wait = time.Duration(n) * time.Minute
+	}
+	return o
+}
+
+// CooldownError is returned by Submit, without contacting the site, while the
+// wait requested by an earlier response has not elapsed.
+type CooldownError struct {
+	Until time.Time
+}
+
+func (e *CooldownError) Error() string {
+	return fmt.Sprintf("site: submissions are on cooldown until %s", e.Until.Format(time.TimeOnly))
+}
+
+// GuessedError is returned by Submit, without contacting the site, when the
+// answer is already known to be wrong from an earlier submission.
+type GuessedError struct {
+	Answer string
+	Guess  Guess // the earlier submission that rules Answer out
+}
+
+func (e *GuessedError) Error() string {
+	if e.Guess.Answer == e.Answer {
+		return fmt.Sprintf("site: %s was already submitted and judged %s", e.Answer, e.Guess.Verdict)
+	}
+	return fmt.Sprintf("site: %s is ruled out because %s was judged %s", e.Answer, e.Guess.Answer, e.Guess.Verdict)
+}
+
+// Guess is a remembered submission.
+type Guess struct {
+	Day     int       `json:"day"`
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Verdict Verdict   `json:"verdict"`
+	Time    time.Time `json:"time"`
+}
+
+// history is what the client remembers about past submissions for one year.
+type history struct {
+	Guesses       []Guess   `json:"guesses"`
+	CooldownUntil time.Time `json:"cooldown_until"`
+}
+
+// rulesOut returns the earlier guess for the same part that shows answer is
+// wrong: the same answer judged wrong, or a number on the far side of a
+// too-high or too-low bound.
+func (h *history) rulesOut(day, part int, answer string) (Guess, bool) {
+	n, numErr := strconv.ParseInt(answer, 10, 64)
+	for _, g := range h.Guesses {
+		if g.Day != day || g.Part != part || !g.Verdict.IsWrong() {
+			continue
+		}
+		if g.Answer == answer {
+			return g, true
+		}
+		bound, err := strconv.ParseInt(g.Answer, 10, 64)
+		if numErr != nil || err != nil {
+			continue
+		}
+		if (g.Verdict == TooHigh && n >= bound) || (g.Verdict == TooLow && n <= bound) {
+			return g, true
+		}
+	}
+	return Guess{}, false
+}
+
+// solved returns the accepted answer for a part, if there is one.
+func (h *history) solved(day, part int) (Guess, bool) {
+	for _, g := range h.Guesses {
+		if g.Day == day && g.Part == part && g.Verdict == Correct {
+			return g, true
+		}
+	}
+	return Guess{}, false
+}
+
+func (c *Client) historyPath() string {
+	return filepath.Join(c.cfg.CacheDir, fmt.Sprint(c.cfg.Year), "submissions.json")
+}
+
+func (c *Client) loadHistory() (*history, error) {
+	h := new(history)
+	data, err := os.ReadFile(c.historyPath())
+	if errors.Is(err, os.ErrNotExist) {
+		return h, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, h); err != nil {
+		return nil, fmt.Errorf("%s: %w", c.historyPath(), err)
+	}
+	return h, nil
+}
+
+func (c *Client) saveHistory(h *history) error {
+	data, err := json.MarshalIndent(h, "", "\t")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(c.historyPath(), data)
+}
+
+// Guesses returns the remembered submissions for a day and part, oldest first.
+func (c *Client) Guesses(day, part int) ([]Guess, error) {
+	h, err := c.loadHistory()
+	if err != nil {
+		return nil, err
+	}
+	var guesses []Guess
+	for _, g := range h.Guesses {
+		if g.Day == day && g.Part == part {
+			guesses = append(guesses, g)
+		}
+	}
+	return guesses, nil
+}
+
+// Submit posts answer for a day and part and returns the site's verdict.
+//
+// Submit never sends an answer the local history already rules out
+// (see GuessedError), reports AlreadySolved without contacting the site once
+// a part has been accepted, and refuses to post while a cooldown requested by
+// the site is still running (see CooldownError).
+func (c *Client) Submit(ctx context.Context, day, part int, answer string) (Outcome, error) {
+	if day < 1 || day > 25 || (part != 1 && part != 2) {
+		return Outcome{}, fmt.Errorf("site: invalid day %d part %d", day, part)
+	}
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return Outcome{}, errors.New("site: empty answer")
+	}
+
+	h, err := c.loadHistory()
+	if err != nil {
+		return Outcome{}, err
+	}
+	if g, ok := h.solved(day, part); ok {
+		return Outcome{Verdict: AlreadySolved, Message: fmt.Sprintf("already solved with %s", g.Answer)}, nil
+	}
+	if g, ok := h.rulesOut(day, part, answer); ok {
+		return Outcome{}, &GuessedError{Answer: answer, Guess: g}
+	}
+	if now := c.now(); now.Before(h.CooldownUntil) {
+		return Outcome{}, &CooldownError{Until: h.CooldownUntil}
+	}
+
+	outcome, err := c.post(ctx, day, part, answer)
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	now := c.now()
+	if outcome.Wait > 0 {
+		h.CooldownUntil = now.Add(outcome.Wait)
+	}
+	switch {
+	case outcome.Verdict == Correct || outcome.Verdict.IsWrong():
+		h.Guesses = append(h.Guesses, Guess{Day: day, Part: part, Answer: answer, Verdict: outcome.Verdict, Time: now})
+	case outcome.Verdict == Unknown:
+		return outcome, fmt.Errorf("site: unrecognised answer response: %q", outcome.Message)
+	}
+	if err := c.saveHistory(h); err != nil {
+		return outcome, err
+	}
+	return outcome, nil
+}
+
+func (c *Client) post(ctx context.Context, day, part int, answer string) (Outcome, error) {
+	path := fmt.Sprintf("/%d/day/%d/answer", c.cfg.Year, day)
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	req, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(c.cfg.BaseURL, "/")+path, strings.NewReader(form.Encode()))
+	if err != nil {
+		return Outcome{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.do(ctx, req)
+	if err != nil {
+		return Outcome{}, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Outcome{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Outcome{}, fmt.Errorf("site: POST %s: %s: %s", path, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return ParseOutcome(string(body)), nil
+}
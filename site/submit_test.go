@@ -0,0 +1,83 @@
+package site
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"adventofcode23/site/sitetest"
+)
+
+func TestParseOutcome(t *testing.T) {
+	tests := []struct {
+		page string
+		want Verdict
+		wait time.Duration
+	}{
+		{`<main><article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer.</p></article></main>`, Correct, 0},
+		{`<article><p>That's not the right answer; your answer is too high.  Please wait one minute before trying again. [<a href="/2023/day/1">Return to Day 1</a>]</p></article>`, TooHigh, time.Minute},
+		{`<article><p>That&apos;s not the right answer; your answer is too low.  please wait 5 minutes before trying again.</p></article>`, TooLow, 5 * time.Minute},
+		{`<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again.</p></article>`, Wrong, time.Minute},
+		{`<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 4m 32s left to wait.</p></article>`, Wait, 4*time.Minute + 32*time.Second},
+		{`<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 45s left to wait.</p></article>`, Wait, 45 * time.Second},
+		{`<article><p>You don't seem to be solving the right level.  Did you already complete it?</p></article>`, AlreadySolved, 0},
+		{`<html><body>Internal error</body></html>`, Unknown, 0},
+	}
+	for _, tt := range tests {
+		got := ParseOutcome(tt.page)
+		if got.Verdict != tt.want || got.Wait != tt.wait {
+			t.Errorf("ParseOutcome(%q) = %v, wait %v; want %v, wait %v", tt.page, got.Verdict, got.Wait, tt.want, tt.wait)
+		}
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	srv := sitetest.NewServer()
+	defer srv.Close()
+	srv.SetAnswer(2023, 1, 1, "142")
+
+	clock := time.Date(2023, 12, 1, 5, 0, 0, 0, time.UTC)
+	srv.Now = func() time.Time { return clock }
+	c := newTestClient(t, srv)
+	c.now = srv.Now
+	ctx := context.Background()
+
+	out, err := c.Submit(ctx, 1, 1, "150")
+	if err != nil || out.Verdict != TooHigh {
+		t.Fatalf("Submit(150) = %v, %v; want too high", out.Verdict, err)
+	}
+
+	var cooldown *CooldownError
+	if _, err := c.Submit(ctx, 1, 1, "100"); !errors.As(err, &cooldown) {
+		t.Fatalf("Submit during cooldown: err = %v, want CooldownError", err)
+	}
+	clock = clock.Add(sitetest.WrongAnswerWait)
+
+	var guessed *GuessedError
+	for _, answer := range []string{"150", "151"} {
+		if _, err := c.Submit(ctx, 1, 1, answer); !errors.As(err, &guessed) {
+			t.Fatalf("Submit(%s) after too high 150: err = %v, want GuessedError", answer, err)
+		}
+	}
+
+	out, err = c.Submit(ctx, 1, 1, "142")
+	if err != nil || out.Verdict != Correct {
+		t.Fatalf("Submit(142) = %v, %v; want correct", out.Verdict, err)
+	}
+	out, err = c.Submit(ctx, 1, 1, "142")
+	if err != nil || out.Verdict != AlreadySolved {
+		t.Fatalf("Submit(142) again = %v, %v; want already solved", out.Verdict, err)
+	}
+
+	if n := srv.Submissions(); n != 2 {
+		t.Errorf("server saw %d submissions, want 2", n)
+	}
+	guesses, err := c.Guesses(1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(guesses) != 2 || guesses[0].Verdict != TooHigh || guesses[1].Verdict != Correct {
+		t.Errorf("Guesses = %+v", guesses)
+	}
+}
@@ -0,0 +1,153 @@
+// Package verify checks registered solvers against the answers manifest, a
+// list of accepted answers for the puzzle inputs committed to the repository.
+package verify
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"adventofcode23/aoc"
+	"adventofcode23/input"
+)
+
+// Entry is one line of the manifest.
+type Entry struct {
+	Day, Part int
+	// Input is the puzzle input path, resolved against the manifest's
+	// directory, or the name of the day's embedded example.
+	Input    string
+	Expected string
+}
+
+// Load reads a manifest: one tab or space separated "day part input expected"
+// entry per line, with blank lines and lines starting with '#' ignored.
+func Load(path string) ([]Entry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	entries, err := Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	dir := filepath.Dir(path)
+	for i := range entries {
+		if in := entries[i].Input; in != input.Example && in != input.Stdin && !filepath.IsAbs(in) {
+			entries[i].Input = filepath.Join(dir, in)
+		}
+	}
+	return entries, nil
+}
+
+// Parse reads manifest entries from r without resolving input paths.
+func Parse(r io.Reader) ([]Entry, error) {
+	var entries []Entry
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 4 {
+			return nil, fmt.Errorf("line %d: want 4 fields (day part input expected), got %d", lineNo, len(fields))
+		}
+		day, err := strconv.Atoi(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid day %q", lineNo, fields[0])
+		}
+		part, err := strconv.Atoi(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid part %q", lineNo, fields[1])
+		}
+		entries = append(entries, Entry{Day: day, Part: part, Input: fields[2], Expected: fields[3]})
+	}
+	return entries, scanner.Err()
+}
+
+// Result is the outcome of running one solver against one manifest entry.
+type Result struct {
+	Entry
+	Solver aoc.Solver // nil when no solver is registered for the entry
+	Got    string
+	Err    error
+}
+
+// OK reports whether the solver produced the expected answer.
+func (r Result) OK() bool {
+	return r.Solver != nil && r.Err == nil && r.Got == r.Expected
+}
+
+// Check runs every variant registered for the entry's day and part. A
+// positive timeout limits each solver's run.
+func Check(ctx context.Context, e Entry, timeout time.Duration) []Result {
+	solvers := aoc.Solvers(aoc.Filter{Day: e.Day, Part: e.Part})
+	if len(solvers) == 0 {
+		return []Result{{Entry: e, Err: fmt.Errorf("no solver registered")}}
+	}
+
+	results := make([]Result, 0, len(solvers))
+	for _, s := range solvers {
+		results = append(results, checkOne(ctx, e, s, timeout))
+	}
+	return results
+}
+
+func checkOne(ctx context.Context, e Entry, s aoc.Solver, timeout time.Duration) Result {
+	res := Result{Entry: e, Solver: s}
+	file, err := input.Open(e.Day, e.Input)
+	if err != nil {
+		res.Err = err
+		return res
+	}
+	defer file.Close()
+
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	answer, err := s.Solve(ctx, file)
+	if err != nil {
+		res.Err = input.WithFile(err, filepath.Base(e.Input))
+		return res
+	}
+	res.Got = answer.String()
+	return res
+}
+
+// WriteTable prints results as a diff-style table: matching rows are
+// indented, and each mismatch shows the expected answer on a '-' row
+// followed by what the solver produced on a '+' row.
+func WriteTable(w io.Writer, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "  SOLVER\tINPUT\tANSWER")
+	for _, r := range results {
+		name := fmt.Sprintf("%d/%d", r.Day, r.Part)
+		if r.Solver != nil {
+			name = aoc.Name(r.Solver)
+		}
+		input := filepath.Base(r.Input)
+		switch {
+		case r.OK():
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", name, input, r.Got)
+		case r.Err != nil:
+			fmt.Fprintf(tw, "- %s\t%s\t%s\n", name, input, r.Expected)
+			fmt.Fprintf(tw, "+ %s\t%s\terror: %v\n", name, input, r.Err)
+		default:
+			fmt.Fprintf(tw, "- %s\t%s\t%s\n", name, input, r.Expected)
+			fmt.Fprintf(tw, "+ %s\t%s\t%s\n", name, input, r.Got)
+		}
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,54 @@
+package verify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"adventofcode23/aoc"
+	_ "adventofcode23/days"
+)
+
+// TestAnswers runs every solver against the committed inputs and compares
+// the results with answers.txt.
+func TestAnswers(t *testing.T) {
+	entries, err := Load("../answers.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, e := range entries {
+		e := e
+		t.Run(fmt.Sprintf("day%d/part%d", e.Day, e.Part), func(t *testing.T) {
+			t.Parallel()
+			results := Check(context.Background(), e, 0)
+			for _, r := range results {
+				if !r.OK() {
+					var buf bytes.Buffer
+					WriteTable(&buf, results)
+					t.Fatalf("answers differ from manifest:\n%s", buf.String())
+				}
+			}
+		})
+	}
+}
+
+// TestCanceled checks that a solver with a long loop stops on a done context
+// and says how far it got.
+func TestCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The default variant tries every hold time; the quadratic one is done
+	// before it would next look at the context.
+	results := Check(ctx, Entry{Day: 6, Part: 2, Input: "../day6.txt"}, 0)
+	var ce *aoc.CanceledError
+	if len(results) == 0 || results[0].Solver.Variant() != aoc.DefaultVariant || !errors.As(results[0].Err, &ce) {
+		t.Fatalf("Check with a canceled context = %+v, want a CanceledError", results)
+	}
+	if !errors.Is(results[0].Err, context.Canceled) || ce.Progress == "" {
+		t.Errorf("error %q does not wrap context.Canceled with progress", results[0].Err)
+	}
+}